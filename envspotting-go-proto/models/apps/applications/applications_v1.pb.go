@@ -0,0 +1,1336 @@
+/// This file has messages for describing applications
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.7
+// source: apps/applications/applications_v1.proto
+
+package applications
+
+import (
+	contours "github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	common "github.com/badhouseplants/envspotting-go-proto/models/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+//*
+// Represents an application name only
+type AppNameAndDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // Application name
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // Application brief description
+}
+
+func (x *AppNameAndDescription) Reset() {
+	*x = AppNameAndDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppNameAndDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppNameAndDescription) ProtoMessage() {}
+
+func (x *AppNameAndDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppNameAndDescription.ProtoReflect.Descriptor instead.
+func (*AppNameAndDescription) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AppNameAndDescription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppNameAndDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+//*
+// Represents an application UUID only
+type AppId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Application id: UUID
+}
+
+func (x *AppId) Reset() {
+	*x = AppId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppId) ProtoMessage() {}
+
+func (x *AppId) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppId.ProtoReflect.Descriptor instead.
+func (*AppId) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+//*
+// Represents an application name and id
+type AppIdAndName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // UUID
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Application name: Unique string
+}
+
+func (x *AppIdAndName) Reset() {
+	*x = AppIdAndName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppIdAndName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppIdAndName) ProtoMessage() {}
+
+func (x *AppIdAndName) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppIdAndName.ProtoReflect.Descriptor instead.
+func (*AppIdAndName) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppIdAndName) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppIdAndName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//*
+// Represents a application
+type AppWithoutContours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // UUID
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Application name: Unique string
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Application brief description
+}
+
+func (x *AppWithoutContours) Reset() {
+	*x = AppWithoutContours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppWithoutContours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppWithoutContours) ProtoMessage() {}
+
+func (x *AppWithoutContours) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppWithoutContours.ProtoReflect.Descriptor instead.
+func (*AppWithoutContours) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AppWithoutContours) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppWithoutContours) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppWithoutContours) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+//*
+// Represents an application with contours
+type AppFullInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // UUID
+	Name        string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Application name: Unique string
+	Description string                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Application brief description
+	Contours    []*contours.ContourInfo `protobuf:"bytes,4,rep,name=contours,proto3" json:"contours,omitempty"`       // Map: <contour name: [services]>
+}
+
+func (x *AppFullInfo) Reset() {
+	*x = AppFullInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppFullInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppFullInfo) ProtoMessage() {}
+
+func (x *AppFullInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppFullInfo.ProtoReflect.Descriptor instead.
+func (*AppFullInfo) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AppFullInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppFullInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppFullInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AppFullInfo) GetContours() []*contours.ContourInfo {
+	if x != nil {
+		return x.Contours
+	}
+	return nil
+}
+
+type ListOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added bool `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+}
+
+func (x *ListOptions) Reset() {
+	*x = ListOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptions) ProtoMessage() {}
+
+func (x *ListOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptions.ProtoReflect.Descriptor instead.
+func (*ListOptions) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOptions) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+//*
+// Represents a connection of an application to a gitlab instance
+type GitlabConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId       string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`                      // UUID
+	Url         string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                       // URL of a self-hosted instance, gitlab.com is used if empty
+	Token       string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`                                   // Access token, it's stored encrypted
+	CaBundle    string `protobuf:"bytes,4,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`             // PEM encoded certificates of a self-hosted instance
+	LockGroupId int64  `protobuf:"varint,5,opt,name=lock_group_id,json=lockGroupId,proto3" json:"lock_group_id,omitempty"` // Group that can still deploy to environments of locked contours
+}
+
+func (x *GitlabConnection) Reset() {
+	*x = GitlabConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitlabConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitlabConnection) ProtoMessage() {}
+
+func (x *GitlabConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitlabConnection.ProtoReflect.Descriptor instead.
+func (*GitlabConnection) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GitlabConnection) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GitlabConnection) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GitlabConnection) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GitlabConnection) GetCaBundle() string {
+	if x != nil {
+		return x.CaBundle
+	}
+	return ""
+}
+
+func (x *GitlabConnection) GetLockGroupId() int64 {
+	if x != nil {
+		return x.LockGroupId
+	}
+	return 0
+}
+
+//*
+// Represents a connection of an application to github
+type GithubConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // UUID
+	Url   string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                  // URL of a github enterprise api, api.github.com is used if empty
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`              // Access token, it's stored encrypted
+}
+
+func (x *GithubConnection) Reset() {
+	*x = GithubConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GithubConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GithubConnection) ProtoMessage() {}
+
+func (x *GithubConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GithubConnection.ProtoReflect.Descriptor instead.
+func (*GithubConnection) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GithubConnection) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GithubConnection) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GithubConnection) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+//*
+// Represents a new access token of an application
+type AppIdAndToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // UUID
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AppIdAndToken) Reset() {
+	*x = AppIdAndToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppIdAndToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppIdAndToken) ProtoMessage() {}
+
+func (x *AppIdAndToken) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppIdAndToken.ProtoReflect.Descriptor instead.
+func (*AppIdAndToken) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AppIdAndToken) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppIdAndToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+//*
+// Represents the user owning a connection token
+type ConnectionUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ConnectionUser) Reset() {
+	*x = ConnectionUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionUser) ProtoMessage() {}
+
+func (x *ConnectionUser) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionUser.ProtoReflect.Descriptor instead.
+func (*ConnectionUser) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConnectionUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+//*
+// Represents a rule of an application to create contours for merge requests.
+// Every merge request of one of the projects gets a contour
+// with a service per project in environments named by the pattern
+type EphemeralContourRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId              string  `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`                                        // UUID
+	Projects           []int64 `protobuf:"varint,2,rep,packed,name=projects,proto3" json:"projects,omitempty"`                                       // Project IDs from Gitlab
+	EnvironmentPattern string  `protobuf:"bytes,3,opt,name=environment_pattern,json=environmentPattern,proto3" json:"environment_pattern,omitempty"` // e.g. review/{branch}, {branch_slug} and {iid} can be used too
+}
+
+func (x *EphemeralContourRule) Reset() {
+	*x = EphemeralContourRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EphemeralContourRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EphemeralContourRule) ProtoMessage() {}
+
+func (x *EphemeralContourRule) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EphemeralContourRule.ProtoReflect.Descriptor instead.
+func (*EphemeralContourRule) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EphemeralContourRule) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *EphemeralContourRule) GetProjects() []int64 {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *EphemeralContourRule) GetEnvironmentPattern() string {
+	if x != nil {
+		return x.EnvironmentPattern
+	}
+	return ""
+}
+
+//*
+// Represents a commit of a gitlab project selected by one of sha, tag or merge request
+type DeployedCommitQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectPath     string `protobuf:"bytes,1,opt,name=project_path,json=projectPath,proto3" json:"project_path,omitempty"` // Path with namespace, e.g. group/project
+	Sha             string `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
+	Tag             string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	MergeRequestIid int64  `protobuf:"varint,4,opt,name=merge_request_iid,json=mergeRequestIid,proto3" json:"merge_request_iid,omitempty"` // Selects the merge commit, or the head of a merge request that isn't merged yet
+}
+
+func (x *DeployedCommitQuery) Reset() {
+	*x = DeployedCommitQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployedCommitQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployedCommitQuery) ProtoMessage() {}
+
+func (x *DeployedCommitQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployedCommitQuery.ProtoReflect.Descriptor instead.
+func (*DeployedCommitQuery) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeployedCommitQuery) GetProjectPath() string {
+	if x != nil {
+		return x.ProjectPath
+	}
+	return ""
+}
+
+func (x *DeployedCommitQuery) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *DeployedCommitQuery) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeployedCommitQuery) GetMergeRequestIid() int64 {
+	if x != nil {
+		return x.MergeRequestIid
+	}
+	return 0
+}
+
+//*
+// Represents a contour whose service environment has the commit deployed
+type DeployedContour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId       string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // UUID
+	ContourId   string `protobuf:"bytes,2,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	ServiceId   string `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project     int64  `protobuf:"varint,4,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	Environment int64  `protobuf:"varint,5,opt,name=environment,proto3" json:"environment,omitempty"`             // Environment ID from Gitlab
+	CommitSha   string `protobuf:"bytes,6,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	DeployedSha string `protobuf:"bytes,7,opt,name=deployed_sha,json=deployedSha,proto3" json:"deployed_sha,omitempty"`
+	Error       string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"` // Set when the deployment of this service can't be checked
+}
+
+func (x *DeployedContour) Reset() {
+	*x = DeployedContour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployedContour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployedContour) ProtoMessage() {}
+
+func (x *DeployedContour) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployedContour.ProtoReflect.Descriptor instead.
+func (*DeployedContour) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeployedContour) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *DeployedContour) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *DeployedContour) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DeployedContour) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *DeployedContour) GetEnvironment() int64 {
+	if x != nil {
+		return x.Environment
+	}
+	return 0
+}
+
+func (x *DeployedContour) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *DeployedContour) GetDeployedSha() string {
+	if x != nil {
+		return x.DeployedSha
+	}
+	return ""
+}
+
+func (x *DeployedContour) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents contours that have a commit deployed
+type DeployedContours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contours []*DeployedContour `protobuf:"bytes,1,rep,name=contours,proto3" json:"contours,omitempty"`
+}
+
+func (x *DeployedContours) Reset() {
+	*x = DeployedContours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_applications_applications_v1_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployedContours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployedContours) ProtoMessage() {}
+
+func (x *DeployedContours) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_applications_applications_v1_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployedContours.ProtoReflect.Descriptor instead.
+func (*DeployedContours) Descriptor() ([]byte, []int) {
+	return file_apps_applications_applications_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeployedContours) GetContours() []*DeployedContour {
+	if x != nil {
+		return x.Contours
+	}
+	return nil
+}
+
+var File_apps_applications_applications_v1_proto protoreflect.FileDescriptor
+
+var file_apps_applications_applications_v1_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x61, 0x70, 0x70, 0x73, 0x1a,
+	0x1f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f,
+	0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x32, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x57, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x82, 0x01, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x23, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x47,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22,
+	0x51, 0x0a, 0x10, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x3c, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x2c, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7a,
+	0x0a, 0x14, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x6f,
+	0x75, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x69, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x53, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x45, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x32, 0xd6, 0x06, 0x0a, 0x0c, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x57, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x00, 0x12, 0x27, 0x0a,
+	0x03, 0x47, 0x65, 0x74, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49,
+	0x64, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x46, 0x75, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x57, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x3e, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x41, 0x70, 0x70, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x6f,
+	0x75, 0x72, 0x73, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x57, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x41, 0x70, 0x70, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c,
+	0x61, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x61,
+	0x70, 0x70, 0x73, 0x2e, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x14,
+	0x54, 0x65, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49,
+	0x64, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x11, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x13, 0x53,
+	0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f,
+	0x6e, 0x74, 0x6f, 0x75, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x61,
+	0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x64, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75,
+	0x72, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75,
+	0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x41, 0x70, 0x70,
+	0x49, 0x64, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x57, 0x68,
+	0x65, 0x72, 0x65, 0x49, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73,
+	0x22, 0x00, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x64, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x73, 0x2f,
+	0x65, 0x6e, 0x76, 0x73, 0x70, 0x6f, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2d, 0x67, 0x6f, 0x2d, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x73,
+	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_applications_applications_v1_proto_rawDescOnce sync.Once
+	file_apps_applications_applications_v1_proto_rawDescData = file_apps_applications_applications_v1_proto_rawDesc
+)
+
+func file_apps_applications_applications_v1_proto_rawDescGZIP() []byte {
+	file_apps_applications_applications_v1_proto_rawDescOnce.Do(func() {
+		file_apps_applications_applications_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_applications_applications_v1_proto_rawDescData)
+	})
+	return file_apps_applications_applications_v1_proto_rawDescData
+}
+
+var file_apps_applications_applications_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_apps_applications_applications_v1_proto_goTypes = []interface{}{
+	(*AppNameAndDescription)(nil), // 0: apps.AppNameAndDescription
+	(*AppId)(nil),                 // 1: apps.AppId
+	(*AppIdAndName)(nil),          // 2: apps.AppIdAndName
+	(*AppWithoutContours)(nil),    // 3: apps.AppWithoutContours
+	(*AppFullInfo)(nil),           // 4: apps.AppFullInfo
+	(*ListOptions)(nil),           // 5: apps.ListOptions
+	(*GitlabConnection)(nil),      // 6: apps.GitlabConnection
+	(*GithubConnection)(nil),      // 7: apps.GithubConnection
+	(*AppIdAndToken)(nil),         // 8: apps.AppIdAndToken
+	(*ConnectionUser)(nil),        // 9: apps.ConnectionUser
+	(*EphemeralContourRule)(nil),  // 10: apps.EphemeralContourRule
+	(*DeployedCommitQuery)(nil),   // 11: apps.DeployedCommitQuery
+	(*DeployedContour)(nil),       // 12: apps.DeployedContour
+	(*DeployedContours)(nil),      // 13: apps.DeployedContours
+	(*contours.ContourInfo)(nil),  // 14: apps.ContourInfo
+	(*common.EmptyMessage)(nil),   // 15: common.EmptyMessage
+}
+var file_apps_applications_applications_v1_proto_depIdxs = []int32{
+	14, // 0: apps.AppFullInfo.contours:type_name -> apps.ContourInfo
+	12, // 1: apps.DeployedContours.contours:type_name -> apps.DeployedContour
+	0,  // 2: apps.Applications.Create:input_type -> apps.AppNameAndDescription
+	1,  // 3: apps.Applications.Get:input_type -> apps.AppId
+	5,  // 4: apps.Applications.List:input_type -> apps.ListOptions
+	3,  // 5: apps.Applications.Update:input_type -> apps.AppWithoutContours
+	2,  // 6: apps.Applications.Delete:input_type -> apps.AppIdAndName
+	6,  // 7: apps.Applications.SetGitlabConnection:input_type -> apps.GitlabConnection
+	1,  // 8: apps.Applications.TestGitlabConnection:input_type -> apps.AppId
+	8,  // 9: apps.Applications.RotateGitlabToken:input_type -> apps.AppIdAndToken
+	7,  // 10: apps.Applications.SetGithubConnection:input_type -> apps.GithubConnection
+	10, // 11: apps.Applications.SetEphemeralContourRule:input_type -> apps.EphemeralContourRule
+	1,  // 12: apps.Applications.GetEphemeralContourRule:input_type -> apps.AppId
+	1,  // 13: apps.Applications.DeleteEphemeralContourRule:input_type -> apps.AppId
+	11, // 14: apps.Applications.WhereIsDeployed:input_type -> apps.DeployedCommitQuery
+	3,  // 15: apps.Applications.Create:output_type -> apps.AppWithoutContours
+	4,  // 16: apps.Applications.Get:output_type -> apps.AppFullInfo
+	3,  // 17: apps.Applications.List:output_type -> apps.AppWithoutContours
+	3,  // 18: apps.Applications.Update:output_type -> apps.AppWithoutContours
+	15, // 19: apps.Applications.Delete:output_type -> common.EmptyMessage
+	9,  // 20: apps.Applications.SetGitlabConnection:output_type -> apps.ConnectionUser
+	9,  // 21: apps.Applications.TestGitlabConnection:output_type -> apps.ConnectionUser
+	9,  // 22: apps.Applications.RotateGitlabToken:output_type -> apps.ConnectionUser
+	9,  // 23: apps.Applications.SetGithubConnection:output_type -> apps.ConnectionUser
+	15, // 24: apps.Applications.SetEphemeralContourRule:output_type -> common.EmptyMessage
+	10, // 25: apps.Applications.GetEphemeralContourRule:output_type -> apps.EphemeralContourRule
+	15, // 26: apps.Applications.DeleteEphemeralContourRule:output_type -> common.EmptyMessage
+	13, // 27: apps.Applications.WhereIsDeployed:output_type -> apps.DeployedContours
+	15, // [15:28] is the sub-list for method output_type
+	2,  // [2:15] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_apps_applications_applications_v1_proto_init() }
+func file_apps_applications_applications_v1_proto_init() {
+	if File_apps_applications_applications_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_applications_applications_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppNameAndDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppIdAndName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppWithoutContours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppFullInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitlabConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GithubConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppIdAndToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EphemeralContourRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployedCommitQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployedContour); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_applications_applications_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployedContours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_applications_applications_v1_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apps_applications_applications_v1_proto_goTypes,
+		DependencyIndexes: file_apps_applications_applications_v1_proto_depIdxs,
+		MessageInfos:      file_apps_applications_applications_v1_proto_msgTypes,
+	}.Build()
+	File_apps_applications_applications_v1_proto = out.File
+	file_apps_applications_applications_v1_proto_rawDesc = nil
+	file_apps_applications_applications_v1_proto_goTypes = nil
+	file_apps_applications_applications_v1_proto_depIdxs = nil
+}
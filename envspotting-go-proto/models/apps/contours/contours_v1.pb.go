@@ -0,0 +1,5169 @@
+/// This file has messages for describing contours
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.7
+// source: apps/contours/contours_v1.proto
+
+package contours
+
+import (
+	common "github.com/badhouseplants/envspotting-go-proto/models/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DriftState int32
+
+const (
+	DriftState_DRIFT_STATE_UNKNOWN_UNSPECIFIED DriftState = 0 // Drift can't be computed
+	DriftState_DRIFT_STATE_IDENTICAL           DriftState = 1
+	DriftState_DRIFT_STATE_DIFFERING           DriftState = 2
+	DriftState_DRIFT_STATE_ONLY_IN_BASE        DriftState = 3
+	DriftState_DRIFT_STATE_ONLY_IN_TARGET      DriftState = 4
+)
+
+// Enum value maps for DriftState.
+var (
+	DriftState_name = map[int32]string{
+		0: "DRIFT_STATE_UNKNOWN_UNSPECIFIED",
+		1: "DRIFT_STATE_IDENTICAL",
+		2: "DRIFT_STATE_DIFFERING",
+		3: "DRIFT_STATE_ONLY_IN_BASE",
+		4: "DRIFT_STATE_ONLY_IN_TARGET",
+	}
+	DriftState_value = map[string]int32{
+		"DRIFT_STATE_UNKNOWN_UNSPECIFIED": 0,
+		"DRIFT_STATE_IDENTICAL":           1,
+		"DRIFT_STATE_DIFFERING":           2,
+		"DRIFT_STATE_ONLY_IN_BASE":        3,
+		"DRIFT_STATE_ONLY_IN_TARGET":      4,
+	}
+)
+
+func (x DriftState) Enum() *DriftState {
+	p := new(DriftState)
+	*p = x
+	return p
+}
+
+func (x DriftState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftState) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_contours_contours_v1_proto_enumTypes[0].Descriptor()
+}
+
+func (DriftState) Type() protoreflect.EnumType {
+	return &file_apps_contours_contours_v1_proto_enumTypes[0]
+}
+
+func (x DriftState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftState.Descriptor instead.
+func (DriftState) EnumDescriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{0}
+}
+
+type EnvironmentAction int32
+
+const (
+	EnvironmentAction_ENVIRONMENT_ACTION_UNKNOWN_UNSPECIFIED EnvironmentAction = 0
+	EnvironmentAction_ENVIRONMENT_ACTION_STOP                EnvironmentAction = 1
+	EnvironmentAction_ENVIRONMENT_ACTION_START               EnvironmentAction = 2 // Retries the last successful deploy job
+	EnvironmentAction_ENVIRONMENT_ACTION_LOCK                EnvironmentAction = 3
+	EnvironmentAction_ENVIRONMENT_ACTION_UNLOCK              EnvironmentAction = 4
+)
+
+// Enum value maps for EnvironmentAction.
+var (
+	EnvironmentAction_name = map[int32]string{
+		0: "ENVIRONMENT_ACTION_UNKNOWN_UNSPECIFIED",
+		1: "ENVIRONMENT_ACTION_STOP",
+		2: "ENVIRONMENT_ACTION_START",
+		3: "ENVIRONMENT_ACTION_LOCK",
+		4: "ENVIRONMENT_ACTION_UNLOCK",
+	}
+	EnvironmentAction_value = map[string]int32{
+		"ENVIRONMENT_ACTION_UNKNOWN_UNSPECIFIED": 0,
+		"ENVIRONMENT_ACTION_STOP":                1,
+		"ENVIRONMENT_ACTION_START":               2,
+		"ENVIRONMENT_ACTION_LOCK":                3,
+		"ENVIRONMENT_ACTION_UNLOCK":              4,
+	}
+)
+
+func (x EnvironmentAction) Enum() *EnvironmentAction {
+	p := new(EnvironmentAction)
+	*p = x
+	return p
+}
+
+func (x EnvironmentAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnvironmentAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_contours_contours_v1_proto_enumTypes[1].Descriptor()
+}
+
+func (EnvironmentAction) Type() protoreflect.EnumType {
+	return &file_apps_contours_contours_v1_proto_enumTypes[1]
+}
+
+func (x EnvironmentAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnvironmentAction.Descriptor instead.
+func (EnvironmentAction) EnumDescriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{1}
+}
+
+type Health int32
+
+const (
+	Health_HEALTH_UNKNOWN_UNSPECIFIED Health = 0 // Nothing is known about the deployment
+	Health_HEALTH_HEALTHY             Health = 1
+	Health_HEALTH_DEPLOYING           Health = 2
+	Health_HEALTH_FAILED              Health = 3
+)
+
+// Enum value maps for Health.
+var (
+	Health_name = map[int32]string{
+		0: "HEALTH_UNKNOWN_UNSPECIFIED",
+		1: "HEALTH_HEALTHY",
+		2: "HEALTH_DEPLOYING",
+		3: "HEALTH_FAILED",
+	}
+	Health_value = map[string]int32{
+		"HEALTH_UNKNOWN_UNSPECIFIED": 0,
+		"HEALTH_HEALTHY":             1,
+		"HEALTH_DEPLOYING":           2,
+		"HEALTH_FAILED":              3,
+	}
+)
+
+func (x Health) Enum() *Health {
+	p := new(Health)
+	*p = x
+	return p
+}
+
+func (x Health) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Health) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_contours_contours_v1_proto_enumTypes[2].Descriptor()
+}
+
+func (Health) Type() protoreflect.EnumType {
+	return &file_apps_contours_contours_v1_proto_enumTypes[2]
+}
+
+func (x Health) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Health.Descriptor instead.
+func (Health) EnumDescriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{2}
+}
+
+type VariableAction int32
+
+const (
+	VariableAction_VARIABLE_ACTION_UNKNOWN_UNSPECIFIED VariableAction = 0
+	VariableAction_VARIABLE_ACTION_CREATE              VariableAction = 1
+	VariableAction_VARIABLE_ACTION_UPDATE              VariableAction = 2
+	VariableAction_VARIABLE_ACTION_DELETE              VariableAction = 3
+)
+
+// Enum value maps for VariableAction.
+var (
+	VariableAction_name = map[int32]string{
+		0: "VARIABLE_ACTION_UNKNOWN_UNSPECIFIED",
+		1: "VARIABLE_ACTION_CREATE",
+		2: "VARIABLE_ACTION_UPDATE",
+		3: "VARIABLE_ACTION_DELETE",
+	}
+	VariableAction_value = map[string]int32{
+		"VARIABLE_ACTION_UNKNOWN_UNSPECIFIED": 0,
+		"VARIABLE_ACTION_CREATE":              1,
+		"VARIABLE_ACTION_UPDATE":              2,
+		"VARIABLE_ACTION_DELETE":              3,
+	}
+)
+
+func (x VariableAction) Enum() *VariableAction {
+	p := new(VariableAction)
+	*p = x
+	return p
+}
+
+func (x VariableAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariableAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_contours_contours_v1_proto_enumTypes[3].Descriptor()
+}
+
+func (VariableAction) Type() protoreflect.EnumType {
+	return &file_apps_contours_contours_v1_proto_enumTypes[3]
+}
+
+func (x VariableAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VariableAction.Descriptor instead.
+func (VariableAction) EnumDescriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{3}
+}
+
+type ReleaseNotesFormat int32
+
+const (
+	ReleaseNotesFormat_RELEASE_NOTES_FORMAT_MARKDOWN_UNSPECIFIED ReleaseNotesFormat = 0
+	ReleaseNotesFormat_RELEASE_NOTES_FORMAT_JSON                 ReleaseNotesFormat = 1
+)
+
+// Enum value maps for ReleaseNotesFormat.
+var (
+	ReleaseNotesFormat_name = map[int32]string{
+		0: "RELEASE_NOTES_FORMAT_MARKDOWN_UNSPECIFIED",
+		1: "RELEASE_NOTES_FORMAT_JSON",
+	}
+	ReleaseNotesFormat_value = map[string]int32{
+		"RELEASE_NOTES_FORMAT_MARKDOWN_UNSPECIFIED": 0,
+		"RELEASE_NOTES_FORMAT_JSON":                 1,
+	}
+)
+
+func (x ReleaseNotesFormat) Enum() *ReleaseNotesFormat {
+	p := new(ReleaseNotesFormat)
+	*p = x
+	return p
+}
+
+func (x ReleaseNotesFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReleaseNotesFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_contours_contours_v1_proto_enumTypes[4].Descriptor()
+}
+
+func (ReleaseNotesFormat) Type() protoreflect.EnumType {
+	return &file_apps_contours_contours_v1_proto_enumTypes[4]
+}
+
+func (x ReleaseNotesFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReleaseNotesFormat.Descriptor instead.
+func (ReleaseNotesFormat) EnumDescriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{4}
+}
+
+//*
+// Represents an contour UUID only
+type ContourId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Contour ID: UUID
+}
+
+func (x *ContourId) Reset() {
+	*x = ContourId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourId) ProtoMessage() {}
+
+func (x *ContourId) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourId.ProtoReflect.Descriptor instead.
+func (*ContourId) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContourId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ContoursListOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // Applcation ID: UUID
+}
+
+func (x *ContoursListOption) Reset() {
+	*x = ContoursListOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContoursListOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContoursListOption) ProtoMessage() {}
+
+func (x *ContoursListOption) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContoursListOption.ProtoReflect.Descriptor instead.
+func (*ContoursListOption) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ContoursListOption) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ContourIdAndName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                    // UUID
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                // Contour name: Unique string
+	AppId string `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // Applcation ID: UUID
+}
+
+func (x *ContourIdAndName) Reset() {
+	*x = ContourIdAndName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourIdAndName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourIdAndName) ProtoMessage() {}
+
+func (x *ContourIdAndName) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourIdAndName.ProtoReflect.Descriptor instead.
+func (*ContourIdAndName) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ContourIdAndName) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContourIdAndName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContourIdAndName) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ContourInfoWithoutServices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	AppId       string `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // Applcation ID: UUID
+}
+
+func (x *ContourInfoWithoutServices) Reset() {
+	*x = ContourInfoWithoutServices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourInfoWithoutServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourInfoWithoutServices) ProtoMessage() {}
+
+func (x *ContourInfoWithoutServices) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourInfoWithoutServices.ProtoReflect.Descriptor instead.
+func (*ContourInfoWithoutServices) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContourInfoWithoutServices) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContourInfoWithoutServices) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContourInfoWithoutServices) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ContourInfoWithoutServices) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ContourNameAndDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AppId       string `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // Applcation ID: UUID
+}
+
+func (x *ContourNameAndDescription) Reset() {
+	*x = ContourNameAndDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourNameAndDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourNameAndDescription) ProtoMessage() {}
+
+func (x *ContourNameAndDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourNameAndDescription.ProtoReflect.Descriptor instead.
+func (*ContourNameAndDescription) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContourNameAndDescription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContourNameAndDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ContourNameAndDescription) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+//*
+// Represents a full contour
+type ContourInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // UUID
+	Name        string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Contour name: Unique string
+	Description string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Services    []*ServiceInfo `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`               // Array of maps <projectID:environmentID>
+	AppId       string         `protobuf:"bytes,5,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`        // Applcation ID: UUID
+	Health      Health         `protobuf:"varint,6,opt,name=health,proto3,enum=apps.Health" json:"health,omitempty"` // Worst health of the contour services
+}
+
+func (x *ContourInfo) Reset() {
+	*x = ContourInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourInfo) ProtoMessage() {}
+
+func (x *ContourInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourInfo.ProtoReflect.Descriptor instead.
+func (*ContourInfo) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContourInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContourInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContourInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ContourInfo) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ContourInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ContourInfo) GetHealth() Health {
+	if x != nil {
+		return x.Health
+	}
+	return Health_HEALTH_UNKNOWN_UNSPECIFIED
+}
+
+//*
+// Represents a service without ID
+type ServiceWithoutId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project         int64  `protobuf:"varint,1,opt,name=project,proto3" json:"project,omitempty"`                                       // Project ID from Gitlab
+	Environment     int64  `protobuf:"varint,2,opt,name=environment,proto3" json:"environment,omitempty"`                               //  Environment ID from Gitlab
+	EnvironmentName string `protobuf:"bytes,3,opt,name=environment_name,json=environmentName,proto3" json:"environment_name,omitempty"` // Used to create the environment when it's missing
+}
+
+func (x *ServiceWithoutId) Reset() {
+	*x = ServiceWithoutId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceWithoutId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceWithoutId) ProtoMessage() {}
+
+func (x *ServiceWithoutId) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceWithoutId.ProtoReflect.Descriptor instead.
+func (*ServiceWithoutId) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServiceWithoutId) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ServiceWithoutId) GetEnvironment() int64 {
+	if x != nil {
+		return x.Environment
+	}
+	return 0
+}
+
+func (x *ServiceWithoutId) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+//*
+// Represents a service
+type ServiceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Project         int64  `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                                       // Project ID from Gitlab
+	Environment     int64  `protobuf:"varint,3,opt,name=environment,proto3" json:"environment,omitempty"`                               //  Environment ID from Gitlab
+	EnvironmentName string `protobuf:"bytes,4,opt,name=environment_name,json=environmentName,proto3" json:"environment_name,omitempty"` // Environment name from Gitlab
+}
+
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ServiceInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetEnvironment() int64 {
+	if x != nil {
+		return x.Environment
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+type ServiceIdAndContourId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"`
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	AppId     string `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // Applcation ID: UUID
+}
+
+func (x *ServiceIdAndContourId) Reset() {
+	*x = ServiceIdAndContourId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceIdAndContourId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceIdAndContourId) ProtoMessage() {}
+
+func (x *ServiceIdAndContourId) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceIdAndContourId.ProtoReflect.Descriptor instead.
+func (*ServiceIdAndContourId) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ServiceIdAndContourId) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *ServiceIdAndContourId) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceIdAndContourId) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+//*
+// Represents an array of services
+type RepeatedServiceWithoutId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId                 string              `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"`
+	Services                  []*ServiceWithoutId `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	AppId                     string              `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`                                                                // Applcation ID: UUID
+	CreateMissingEnvironments bool                `protobuf:"varint,4,opt,name=create_missing_environments,json=createMissingEnvironments,proto3" json:"create_missing_environments,omitempty"` // Create environments that can't be found by ID from their names
+	Provider                  string              `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`                                                                       // Provider hosting the services: gitlab or github, gitlab if empty
+}
+
+func (x *RepeatedServiceWithoutId) Reset() {
+	*x = RepeatedServiceWithoutId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedServiceWithoutId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedServiceWithoutId) ProtoMessage() {}
+
+func (x *RepeatedServiceWithoutId) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedServiceWithoutId.ProtoReflect.Descriptor instead.
+func (*RepeatedServiceWithoutId) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RepeatedServiceWithoutId) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *RepeatedServiceWithoutId) GetServices() []*ServiceWithoutId {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *RepeatedServiceWithoutId) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *RepeatedServiceWithoutId) GetCreateMissingEnvironments() bool {
+	if x != nil {
+		return x.CreateMissingEnvironments
+	}
+	return false
+}
+
+func (x *RepeatedServiceWithoutId) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+//*
+// Represents an array of services
+type RepeatedServiceWithId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string         `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"`
+	Services  []*ServiceInfo `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	AppId     string         `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // Applcation ID: UUID
+}
+
+func (x *RepeatedServiceWithId) Reset() {
+	*x = RepeatedServiceWithId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedServiceWithId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedServiceWithId) ProtoMessage() {}
+
+func (x *RepeatedServiceWithId) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedServiceWithId.ProtoReflect.Descriptor instead.
+func (*RepeatedServiceWithId) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RepeatedServiceWithId) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *RepeatedServiceWithId) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *RepeatedServiceWithId) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+//*
+// Represents the latest deployment of a service
+type ServiceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId   string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project     int64                  `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	Environment int64                  `protobuf:"varint,3,opt,name=environment,proto3" json:"environment,omitempty"`             //  Environment ID from Gitlab
+	Ref         string                 `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`                              // Deployed branch or tag
+	ShortSha    string                 `protobuf:"bytes,5,opt,name=short_sha,json=shortSha,proto3" json:"short_sha,omitempty"`    // Deployed commit
+	Status      string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                        // Deployment status: created, running, success, failed or canceled
+	Deployer    string                 `protobuf:"bytes,7,opt,name=deployer,proto3" json:"deployer,omitempty"`                    // Username of the user who deployed
+	FinishedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	ExternalUrl string                 `protobuf:"bytes,9,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"` // Environment URL
+	Error       string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                               // Set when the status of this service can't be fetched
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`      // When the status was fetched from the provider
+	Stale       bool                   `protobuf:"varint,12,opt,name=stale,proto3" json:"stale,omitempty"`                              // Set when the status hasn't been fetched for longer than status_stale_after
+}
+
+func (x *ServiceStatus) Reset() {
+	*x = ServiceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStatus) ProtoMessage() {}
+
+func (x *ServiceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStatus.ProtoReflect.Descriptor instead.
+func (*ServiceStatus) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ServiceStatus) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ServiceStatus) GetEnvironment() int64 {
+	if x != nil {
+		return x.Environment
+	}
+	return 0
+}
+
+func (x *ServiceStatus) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetShortSha() string {
+	if x != nil {
+		return x.ShortSha
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetDeployer() string {
+	if x != nil {
+		return x.Deployer
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ServiceStatus) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ServiceStatus) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+//*
+// Represents deployments of all services in the contour
+type ContourStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string           `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	Services  []*ServiceStatus `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *ContourStatus) Reset() {
+	*x = ContourStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourStatus) ProtoMessage() {}
+
+func (x *ContourStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourStatus.ProtoReflect.Descriptor instead.
+func (*ContourStatus) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ContourStatus) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *ContourStatus) GetServices() []*ServiceStatus {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+//*
+// Represents two contours to compare
+type ContoursToCompare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseContourId   string `protobuf:"bytes,1,opt,name=base_contour_id,json=baseContourId,proto3" json:"base_contour_id,omitempty"`       // UUID
+	TargetContourId string `protobuf:"bytes,2,opt,name=target_contour_id,json=targetContourId,proto3" json:"target_contour_id,omitempty"` // UUID
+}
+
+func (x *ContoursToCompare) Reset() {
+	*x = ContoursToCompare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContoursToCompare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContoursToCompare) ProtoMessage() {}
+
+func (x *ContoursToCompare) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContoursToCompare.ProtoReflect.Descriptor instead.
+func (*ContoursToCompare) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ContoursToCompare) GetBaseContourId() string {
+	if x != nil {
+		return x.BaseContourId
+	}
+	return ""
+}
+
+func (x *ContoursToCompare) GetTargetContourId() string {
+	if x != nil {
+		return x.TargetContourId
+	}
+	return ""
+}
+
+//*
+// Represents what is deployed for one project in two contours
+type ProjectDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project   int64      `protobuf:"varint,1,opt,name=project,proto3" json:"project,omitempty"` // Project ID from Gitlab
+	State     DriftState `protobuf:"varint,2,opt,name=state,proto3,enum=apps.DriftState" json:"state,omitempty"`
+	BaseSha   string     `protobuf:"bytes,3,opt,name=base_sha,json=baseSha,proto3" json:"base_sha,omitempty"`
+	TargetSha string     `protobuf:"bytes,4,opt,name=target_sha,json=targetSha,proto3" json:"target_sha,omitempty"`
+	Ahead     int32      `protobuf:"varint,5,opt,name=ahead,proto3" json:"ahead,omitempty"`   // Commits deployed in the target but not in the base
+	Behind    int32      `protobuf:"varint,6,opt,name=behind,proto3" json:"behind,omitempty"` // Commits deployed in the base but not in the target
+	Error     string     `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`    // Set when the drift of this project can't be computed
+}
+
+func (x *ProjectDrift) Reset() {
+	*x = ProjectDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDrift) ProtoMessage() {}
+
+func (x *ProjectDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDrift.ProtoReflect.Descriptor instead.
+func (*ProjectDrift) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProjectDrift) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ProjectDrift) GetState() DriftState {
+	if x != nil {
+		return x.State
+	}
+	return DriftState_DRIFT_STATE_UNKNOWN_UNSPECIFIED
+}
+
+func (x *ProjectDrift) GetBaseSha() string {
+	if x != nil {
+		return x.BaseSha
+	}
+	return ""
+}
+
+func (x *ProjectDrift) GetTargetSha() string {
+	if x != nil {
+		return x.TargetSha
+	}
+	return ""
+}
+
+func (x *ProjectDrift) GetAhead() int32 {
+	if x != nil {
+		return x.Ahead
+	}
+	return 0
+}
+
+func (x *ProjectDrift) GetBehind() int32 {
+	if x != nil {
+		return x.Behind
+	}
+	return 0
+}
+
+func (x *ProjectDrift) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents the drift of every project between two contours
+type ContoursDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Projects []*ProjectDrift `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *ContoursDrift) Reset() {
+	*x = ContoursDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContoursDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContoursDrift) ProtoMessage() {}
+
+func (x *ContoursDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContoursDrift.ProtoReflect.Descriptor instead.
+func (*ContoursDrift) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ContoursDrift) GetProjects() []*ProjectDrift {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+//*
+// Represents a promotion of the source contour into the target one
+type ContoursToPromote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceContourId string `protobuf:"bytes,1,opt,name=source_contour_id,json=sourceContourId,proto3" json:"source_contour_id,omitempty"` // UUID
+	TargetContourId string `protobuf:"bytes,2,opt,name=target_contour_id,json=targetContourId,proto3" json:"target_contour_id,omitempty"` // UUID
+	DryRun          bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                             // Only plan pipelines without creating them
+}
+
+func (x *ContoursToPromote) Reset() {
+	*x = ContoursToPromote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContoursToPromote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContoursToPromote) ProtoMessage() {}
+
+func (x *ContoursToPromote) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContoursToPromote.ProtoReflect.Descriptor instead.
+func (*ContoursToPromote) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ContoursToPromote) GetSourceContourId() string {
+	if x != nil {
+		return x.SourceContourId
+	}
+	return ""
+}
+
+func (x *ContoursToPromote) GetTargetContourId() string {
+	if x != nil {
+		return x.TargetContourId
+	}
+	return ""
+}
+
+func (x *ContoursToPromote) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+//*
+// Represents a pipeline promoting one project
+type PromoteStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project           int64  `protobuf:"varint,1,opt,name=project,proto3" json:"project,omitempty"` // Project ID from Gitlab
+	Ref               string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`          // Ref the pipeline runs on
+	Sha               string `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`          // Commit deployed in the source contour
+	TargetEnvironment string `protobuf:"bytes,4,opt,name=target_environment,json=targetEnvironment,proto3" json:"target_environment,omitempty"`
+	PipelineId        int64  `protobuf:"varint,5,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // Empty on dry runs
+	Error             string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                              // Set when the pipeline of this project can't be planned or created
+}
+
+func (x *PromoteStep) Reset() {
+	*x = PromoteStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteStep) ProtoMessage() {}
+
+func (x *PromoteStep) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteStep.ProtoReflect.Descriptor instead.
+func (*PromoteStep) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PromoteStep) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *PromoteStep) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *PromoteStep) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *PromoteStep) GetTargetEnvironment() string {
+	if x != nil {
+		return x.TargetEnvironment
+	}
+	return ""
+}
+
+func (x *PromoteStep) GetPipelineId() int64 {
+	if x != nil {
+		return x.PipelineId
+	}
+	return 0
+}
+
+func (x *PromoteStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents pipelines of a promotion
+type PromoteReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*PromoteStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *PromoteReport) Reset() {
+	*x = PromoteReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteReport) ProtoMessage() {}
+
+func (x *PromoteReport) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteReport.ProtoReflect.Descriptor instead.
+func (*PromoteReport) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PromoteReport) GetSteps() []*PromoteStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+//*
+// Represents services to import from a gitlab group
+type GitlabGroupImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId   string `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	AppId       string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // UUID
+	Group       string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`                          // Group path, subgroups are included
+	Environment string `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`              // Environment name, may be a glob like review/*
+	Include     string `protobuf:"bytes,5,opt,name=include,proto3" json:"include,omitempty"`                      // Optional regexp project paths must match
+	Exclude     string `protobuf:"bytes,6,opt,name=exclude,proto3" json:"exclude,omitempty"`                      // Optional regexp project paths must not match
+}
+
+func (x *GitlabGroupImport) Reset() {
+	*x = GitlabGroupImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitlabGroupImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitlabGroupImport) ProtoMessage() {}
+
+func (x *GitlabGroupImport) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitlabGroupImport.ProtoReflect.Descriptor instead.
+func (*GitlabGroupImport) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GitlabGroupImport) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *GitlabGroupImport) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GitlabGroupImport) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GitlabGroupImport) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *GitlabGroupImport) GetInclude() string {
+	if x != nil {
+		return x.Include
+	}
+	return ""
+}
+
+func (x *GitlabGroupImport) GetExclude() string {
+	if x != nil {
+		return x.Exclude
+	}
+	return ""
+}
+
+//*
+// Represents a project environment considered by an import
+type ImportedService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project         int64  `protobuf:"varint,1,opt,name=project,proto3" json:"project,omitempty"` // Project ID from Gitlab
+	ProjectPath     string `protobuf:"bytes,2,opt,name=project_path,json=projectPath,proto3" json:"project_path,omitempty"`
+	Environment     int64  `protobuf:"varint,3,opt,name=environment,proto3" json:"environment,omitempty"` // Environment ID from Gitlab
+	EnvironmentName string `protobuf:"bytes,4,opt,name=environment_name,json=environmentName,proto3" json:"environment_name,omitempty"`
+	SkipReason      string `protobuf:"bytes,5,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"` // Set when the service isn't added
+}
+
+func (x *ImportedService) Reset() {
+	*x = ImportedService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportedService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedService) ProtoMessage() {}
+
+func (x *ImportedService) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedService.ProtoReflect.Descriptor instead.
+func (*ImportedService) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportedService) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ImportedService) GetProjectPath() string {
+	if x != nil {
+		return x.ProjectPath
+	}
+	return ""
+}
+
+func (x *ImportedService) GetEnvironment() int64 {
+	if x != nil {
+		return x.Environment
+	}
+	return 0
+}
+
+func (x *ImportedService) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ImportedService) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+//*
+// Represents services added to a contour by an import and skipped ones
+type ImportReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added   []*ImportedService `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Skipped []*ImportedService `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportReport) GetAdded() []*ImportedService {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ImportReport) GetSkipped() []*ImportedService {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+//*
+// Represents an action done on one service environment
+type EnvironmentProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId   string            `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project     int64             `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	Environment int64             `protobuf:"varint,3,opt,name=environment,proto3" json:"environment,omitempty"`             // Environment ID from Gitlab
+	Action      EnvironmentAction `protobuf:"varint,4,opt,name=action,proto3,enum=apps.EnvironmentAction" json:"action,omitempty"`
+	JobId       int64             `protobuf:"varint,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Retried deploy job when starting an environment
+	Error       string            `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`               // Set when the action failed for this service
+}
+
+func (x *EnvironmentProgress) Reset() {
+	*x = EnvironmentProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvironmentProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentProgress) ProtoMessage() {}
+
+func (x *EnvironmentProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentProgress.ProtoReflect.Descriptor instead.
+func (*EnvironmentProgress) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EnvironmentProgress) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *EnvironmentProgress) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *EnvironmentProgress) GetEnvironment() int64 {
+	if x != nil {
+		return x.Environment
+	}
+	return 0
+}
+
+func (x *EnvironmentProgress) GetAction() EnvironmentAction {
+	if x != nil {
+		return x.Action
+	}
+	return EnvironmentAction_ENVIRONMENT_ACTION_UNKNOWN_UNSPECIFIED
+}
+
+func (x *EnvironmentProgress) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *EnvironmentProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents filters of the contour deployment history
+type DeploymentsListOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string                 `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	ServiceId string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // Limits the history to one service, all services are listed if empty
+	Statuses  []string               `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`                    // Statuses to keep, all statuses are kept if empty
+	Since     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *DeploymentsListOptions) Reset() {
+	*x = DeploymentsListOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploymentsListOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentsListOptions) ProtoMessage() {}
+
+func (x *DeploymentsListOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentsListOptions.ProtoReflect.Descriptor instead.
+func (*DeploymentsListOptions) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeploymentsListOptions) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *DeploymentsListOptions) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DeploymentsListOptions) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *DeploymentsListOptions) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *DeploymentsListOptions) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+//*
+// Represents one deployment of a contour service
+type DeploymentInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId       string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project         int64                  `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	EnvironmentName string                 `protobuf:"bytes,3,opt,name=environment_name,json=environmentName,proto3" json:"environment_name,omitempty"`
+	Id              int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"` // Deployment ID from Gitlab
+	Ref             string                 `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`
+	Sha             string                 `protobuf:"bytes,6,opt,name=sha,proto3" json:"sha,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`     // Deployment status: created, running, success, failed or canceled
+	Deployer        string                 `protobuf:"bytes,8,opt,name=deployer,proto3" json:"deployer,omitempty"` // Username of the user who deployed
+	CommitTitle     string                 `protobuf:"bytes,9,opt,name=commit_title,json=commitTitle,proto3" json:"commit_title,omitempty"`
+	Author          string                 `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"` // Author of the deployed commit
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *DeploymentInfo) Reset() {
+	*x = DeploymentInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploymentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentInfo) ProtoMessage() {}
+
+func (x *DeploymentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentInfo.ProtoReflect.Descriptor instead.
+func (*DeploymentInfo) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeploymentInfo) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetDeployer() string {
+	if x != nil {
+		return x.Deployer
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetCommitTitle() string {
+	if x != nil {
+		return x.CommitTitle
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+//*
+// Represents a merge request merged into a project
+type MergeRequestInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Iid      int64                  `protobuf:"varint,1,opt,name=iid,proto3" json:"iid,omitempty"` // Merge request IID from Gitlab
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Author   string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"` // Username of the author
+	MergedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	WebUrl   string                 `protobuf:"bytes,5,opt,name=web_url,json=webUrl,proto3" json:"web_url,omitempty"`
+}
+
+func (x *MergeRequestInfo) Reset() {
+	*x = MergeRequestInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeRequestInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequestInfo) ProtoMessage() {}
+
+func (x *MergeRequestInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequestInfo.ProtoReflect.Descriptor instead.
+func (*MergeRequestInfo) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MergeRequestInfo) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *MergeRequestInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MergeRequestInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *MergeRequestInfo) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *MergeRequestInfo) GetWebUrl() string {
+	if x != nil {
+		return x.WebUrl
+	}
+	return ""
+}
+
+//*
+// Represents merge requests merged but not yet deployed to a service environment
+type ServicePendingChanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId     string              `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project       int64               `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	DefaultBranch string              `protobuf:"bytes,3,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	DeployedSha   string              `protobuf:"bytes,4,opt,name=deployed_sha,json=deployedSha,proto3" json:"deployed_sha,omitempty"`
+	MergeRequests []*MergeRequestInfo `protobuf:"bytes,5,rep,name=merge_requests,json=mergeRequests,proto3" json:"merge_requests,omitempty"`
+	Error         string              `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"` // Set when pending changes of this service can't be found
+}
+
+func (x *ServicePendingChanges) Reset() {
+	*x = ServicePendingChanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePendingChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePendingChanges) ProtoMessage() {}
+
+func (x *ServicePendingChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePendingChanges.ProtoReflect.Descriptor instead.
+func (*ServicePendingChanges) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ServicePendingChanges) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServicePendingChanges) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ServicePendingChanges) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *ServicePendingChanges) GetDeployedSha() string {
+	if x != nil {
+		return x.DeployedSha
+	}
+	return ""
+}
+
+func (x *ServicePendingChanges) GetMergeRequests() []*MergeRequestInfo {
+	if x != nil {
+		return x.MergeRequests
+	}
+	return nil
+}
+
+func (x *ServicePendingChanges) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents pending changes of every service in the contour
+type ContourPendingChanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*ServicePendingChanges `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *ContourPendingChanges) Reset() {
+	*x = ContourPendingChanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourPendingChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourPendingChanges) ProtoMessage() {}
+
+func (x *ContourPendingChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourPendingChanges.ProtoReflect.Descriptor instead.
+func (*ContourPendingChanges) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ContourPendingChanges) GetServices() []*ServicePendingChanges {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+//*
+// Represents the health of one service and where it comes from
+type ServiceHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId        string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Health           Health `protobuf:"varint,2,opt,name=health,proto3,enum=apps.Health" json:"health,omitempty"`
+	DeploymentStatus string `protobuf:"bytes,3,opt,name=deployment_status,json=deploymentStatus,proto3" json:"deployment_status,omitempty"`
+	PipelineStatus   string `protobuf:"bytes,4,opt,name=pipeline_status,json=pipelineStatus,proto3" json:"pipeline_status,omitempty"`
+}
+
+func (x *ServiceHealth) Reset() {
+	*x = ServiceHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceHealth) ProtoMessage() {}
+
+func (x *ServiceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceHealth.ProtoReflect.Descriptor instead.
+func (*ServiceHealth) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ServiceHealth) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceHealth) GetHealth() Health {
+	if x != nil {
+		return x.Health
+	}
+	return Health_HEALTH_UNKNOWN_UNSPECIFIED
+}
+
+func (x *ServiceHealth) GetDeploymentStatus() string {
+	if x != nil {
+		return x.DeploymentStatus
+	}
+	return ""
+}
+
+func (x *ServiceHealth) GetPipelineStatus() string {
+	if x != nil {
+		return x.PipelineStatus
+	}
+	return ""
+}
+
+//*
+// Represents the health of every service in the contour
+type ContourHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string           `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	Health    Health           `protobuf:"varint,2,opt,name=health,proto3,enum=apps.Health" json:"health,omitempty"`      // Worst health of the services
+	Services  []*ServiceHealth `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *ContourHealth) Reset() {
+	*x = ContourHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourHealth) ProtoMessage() {}
+
+func (x *ContourHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourHealth.ProtoReflect.Descriptor instead.
+func (*ContourHealth) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ContourHealth) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *ContourHealth) GetHealth() Health {
+	if x != nil {
+		return x.Health
+	}
+	return Health_HEALTH_UNKNOWN_UNSPECIFIED
+}
+
+func (x *ContourHealth) GetServices() []*ServiceHealth {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+//*
+// Represents the ref a contour service is expected to run
+type ServicePin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Ref       string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`                              // Tag, branch or sha, empty to remove the pin
+}
+
+func (x *ServicePin) Reset() {
+	*x = ServicePin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePin) ProtoMessage() {}
+
+func (x *ServicePin) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePin.ProtoReflect.Descriptor instead.
+func (*ServicePin) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ServicePin) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServicePin) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+//*
+// Represents pins of contour services
+type ServicePins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string        `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	Pins      []*ServicePin `protobuf:"bytes,2,rep,name=pins,proto3" json:"pins,omitempty"`
+}
+
+func (x *ServicePins) Reset() {
+	*x = ServicePins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePins) ProtoMessage() {}
+
+func (x *ServicePins) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePins.ProtoReflect.Descriptor instead.
+func (*ServicePins) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ServicePins) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *ServicePins) GetPins() []*ServicePin {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+//*
+// Represents the drift of a pinned service from its actual deployment
+type ServicePinDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId   string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project     int64  `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	Environment int64  `protobuf:"varint,3,opt,name=environment,proto3" json:"environment,omitempty"`             // Environment ID from Gitlab
+	Pin         string `protobuf:"bytes,4,opt,name=pin,proto3" json:"pin,omitempty"`
+	PinnedSha   string `protobuf:"bytes,5,opt,name=pinned_sha,json=pinnedSha,proto3" json:"pinned_sha,omitempty"`
+	DeployedRef string `protobuf:"bytes,6,opt,name=deployed_ref,json=deployedRef,proto3" json:"deployed_ref,omitempty"`
+	DeployedSha string `protobuf:"bytes,7,opt,name=deployed_sha,json=deployedSha,proto3" json:"deployed_sha,omitempty"`
+	InSync      bool   `protobuf:"varint,8,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	Error       string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"` // Set when the drift of this service can't be checked
+}
+
+func (x *ServicePinDrift) Reset() {
+	*x = ServicePinDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePinDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePinDrift) ProtoMessage() {}
+
+func (x *ServicePinDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePinDrift.ProtoReflect.Descriptor instead.
+func (*ServicePinDrift) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ServicePinDrift) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServicePinDrift) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ServicePinDrift) GetEnvironment() int64 {
+	if x != nil {
+		return x.Environment
+	}
+	return 0
+}
+
+func (x *ServicePinDrift) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+func (x *ServicePinDrift) GetPinnedSha() string {
+	if x != nil {
+		return x.PinnedSha
+	}
+	return ""
+}
+
+func (x *ServicePinDrift) GetDeployedRef() string {
+	if x != nil {
+		return x.DeployedRef
+	}
+	return ""
+}
+
+func (x *ServicePinDrift) GetDeployedSha() string {
+	if x != nil {
+		return x.DeployedSha
+	}
+	return ""
+}
+
+func (x *ServicePinDrift) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+func (x *ServicePinDrift) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents the drift of every pinned service in the contour
+type ContourPinsDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*ServicePinDrift `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *ContourPinsDrift) Reset() {
+	*x = ContourPinsDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourPinsDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourPinsDrift) ProtoMessage() {}
+
+func (x *ContourPinsDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourPinsDrift.ProtoReflect.Descriptor instead.
+func (*ContourPinsDrift) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ContourPinsDrift) GetServices() []*ServicePinDrift {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+//*
+// Represents a rollback of a service to an earlier deployment
+type ServiceRollback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId    string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`           // UUID
+	DeploymentId int64  `protobuf:"varint,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"` // Deployment the service is rolled back to
+	Ref          string `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
+	Sha          string `protobuf:"bytes,4,opt,name=sha,proto3" json:"sha,omitempty"`
+	JobId        int64  `protobuf:"varint,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Retried deploy job of that deployment
+}
+
+func (x *ServiceRollback) Reset() {
+	*x = ServiceRollback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRollback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRollback) ProtoMessage() {}
+
+func (x *ServiceRollback) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRollback.ProtoReflect.Descriptor instead.
+func (*ServiceRollback) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ServiceRollback) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceRollback) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+func (x *ServiceRollback) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *ServiceRollback) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *ServiceRollback) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+//*
+// Represents a variable of a contour
+type Variable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Letters, digits and '_' only
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Masked bool   `protobuf:"varint,3,opt,name=masked,proto3" json:"masked,omitempty"` // Masked values are hidden in job logs and never listed back
+}
+
+func (x *Variable) Reset() {
+	*x = Variable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable) ProtoMessage() {}
+
+func (x *Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Variable) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Variable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Variable) GetMasked() bool {
+	if x != nil {
+		return x.Masked
+	}
+	return false
+}
+
+//*
+// Represents variables of a contour
+type ContourVariables struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string      `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	Variables []*Variable `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+}
+
+func (x *ContourVariables) Reset() {
+	*x = ContourVariables{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourVariables) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourVariables) ProtoMessage() {}
+
+func (x *ContourVariables) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourVariables.ProtoReflect.Descriptor instead.
+func (*ContourVariables) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ContourVariables) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *ContourVariables) GetVariables() []*Variable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+//*
+// Represents keys of contour variables
+type VariableKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string   `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	Keys      []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *VariableKeys) Reset() {
+	*x = VariableKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariableKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableKeys) ProtoMessage() {}
+
+func (x *VariableKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableKeys.ProtoReflect.Descriptor instead.
+func (*VariableKeys) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VariableKeys) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *VariableKeys) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+//*
+// Represents options of a variables sync
+type VariablesSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	DryRun    bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`         // Only report changes without applying them
+	Prune     bool   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`                         // Delete variables of the service environments that the contour doesn't have
+}
+
+func (x *VariablesSync) Reset() {
+	*x = VariablesSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariablesSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariablesSync) ProtoMessage() {}
+
+func (x *VariablesSync) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariablesSync.ProtoReflect.Descriptor instead.
+func (*VariablesSync) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VariablesSync) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *VariablesSync) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *VariablesSync) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+//*
+// Represents a variable change applied to a service environment
+type VariableChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId   string         `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project     int64          `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	Environment string         `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`              // Environment name
+	Key         string         `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Action      VariableAction `protobuf:"varint,5,opt,name=action,proto3,enum=apps.VariableAction" json:"action,omitempty"`
+	Error       string         `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"` // Set when the change can't be applied
+}
+
+func (x *VariableChange) Reset() {
+	*x = VariableChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariableChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableChange) ProtoMessage() {}
+
+func (x *VariableChange) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableChange.ProtoReflect.Descriptor instead.
+func (*VariableChange) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VariableChange) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *VariableChange) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *VariableChange) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *VariableChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *VariableChange) GetAction() VariableAction {
+	if x != nil {
+		return x.Action
+	}
+	return VariableAction_VARIABLE_ACTION_UNKNOWN_UNSPECIFIED
+}
+
+func (x *VariableChange) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents changes made by a variables sync
+type VariablesSyncReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*VariableChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *VariablesSyncReport) Reset() {
+	*x = VariablesSyncReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariablesSyncReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariablesSyncReport) ProtoMessage() {}
+
+func (x *VariablesSyncReport) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariablesSyncReport.ProtoReflect.Descriptor instead.
+func (*VariablesSyncReport) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VariablesSyncReport) GetChanges() []*VariableChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+//*
+// Represents a merge request to link to a contour
+type MergeRequestToLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContourId string `protobuf:"bytes,1,opt,name=contour_id,json=contourId,proto3" json:"contour_id,omitempty"` // UUID
+	Project   int64  `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	Iid       int64  `protobuf:"varint,3,opt,name=iid,proto3" json:"iid,omitempty"`                             // Merge request IID from Gitlab
+}
+
+func (x *MergeRequestToLink) Reset() {
+	*x = MergeRequestToLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeRequestToLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequestToLink) ProtoMessage() {}
+
+func (x *MergeRequestToLink) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequestToLink.ProtoReflect.Descriptor instead.
+func (*MergeRequestToLink) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MergeRequestToLink) GetContourId() string {
+	if x != nil {
+		return x.ContourId
+	}
+	return ""
+}
+
+func (x *MergeRequestToLink) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *MergeRequestToLink) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+//*
+// Represents a merge request linked to a contour
+type LinkedMergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project  int64                  `protobuf:"varint,1,opt,name=project,proto3" json:"project,omitempty"` // Project ID from Gitlab
+	Iid      int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`         // Merge request IID from Gitlab
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	WebUrl   string                 `protobuf:"bytes,4,opt,name=web_url,json=webUrl,proto3" json:"web_url,omitempty"`
+	NoteId   int64                  `protobuf:"varint,5,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"` // Comment about the contour on the merge request
+	LinkedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+}
+
+func (x *LinkedMergeRequest) Reset() {
+	*x = LinkedMergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkedMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedMergeRequest) ProtoMessage() {}
+
+func (x *LinkedMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedMergeRequest.ProtoReflect.Descriptor instead.
+func (*LinkedMergeRequest) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LinkedMergeRequest) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *LinkedMergeRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *LinkedMergeRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkedMergeRequest) GetWebUrl() string {
+	if x != nil {
+		return x.WebUrl
+	}
+	return ""
+}
+
+func (x *LinkedMergeRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *LinkedMergeRequest) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+//*
+// Represents merge requests linked to a contour
+type ContourMergeRequests struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MergeRequests []*LinkedMergeRequest `protobuf:"bytes,1,rep,name=merge_requests,json=mergeRequests,proto3" json:"merge_requests,omitempty"`
+}
+
+func (x *ContourMergeRequests) Reset() {
+	*x = ContourMergeRequests{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourMergeRequests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourMergeRequests) ProtoMessage() {}
+
+func (x *ContourMergeRequests) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourMergeRequests.ProtoReflect.Descriptor instead.
+func (*ContourMergeRequests) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ContourMergeRequests) GetMergeRequests() []*LinkedMergeRequest {
+	if x != nil {
+		return x.MergeRequests
+	}
+	return nil
+}
+
+//*
+// Represents how far a service environment is behind the default branch of its project
+type ServiceStaleness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId              string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // UUID
+	Project                int64                  `protobuf:"varint,2,opt,name=project,proto3" json:"project,omitempty"`                     // Project ID from Gitlab
+	DefaultBranch          string                 `protobuf:"bytes,3,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	DeployedSha            string                 `protobuf:"bytes,4,opt,name=deployed_sha,json=deployedSha,proto3" json:"deployed_sha,omitempty"`
+	CommitsBehind          int32                  `protobuf:"varint,5,opt,name=commits_behind,json=commitsBehind,proto3" json:"commits_behind,omitempty"`
+	DeployedCommitAge      *durationpb.Duration   `protobuf:"bytes,6,opt,name=deployed_commit_age,json=deployedCommitAge,proto3" json:"deployed_commit_age,omitempty"`                // Time since the deployed commit was committed
+	OldestUndeployedCommit *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=oldest_undeployed_commit,json=oldestUndeployedCommit,proto3" json:"oldest_undeployed_commit,omitempty"` // Not set if the service is up to date
+	Error                  string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                                                                   // Set when the staleness of this service can't be found
+}
+
+func (x *ServiceStaleness) Reset() {
+	*x = ServiceStaleness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceStaleness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStaleness) ProtoMessage() {}
+
+func (x *ServiceStaleness) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStaleness.ProtoReflect.Descriptor instead.
+func (*ServiceStaleness) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ServiceStaleness) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceStaleness) GetProject() int64 {
+	if x != nil {
+		return x.Project
+	}
+	return 0
+}
+
+func (x *ServiceStaleness) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *ServiceStaleness) GetDeployedSha() string {
+	if x != nil {
+		return x.DeployedSha
+	}
+	return ""
+}
+
+func (x *ServiceStaleness) GetCommitsBehind() int32 {
+	if x != nil {
+		return x.CommitsBehind
+	}
+	return 0
+}
+
+func (x *ServiceStaleness) GetDeployedCommitAge() *durationpb.Duration {
+	if x != nil {
+		return x.DeployedCommitAge
+	}
+	return nil
+}
+
+func (x *ServiceStaleness) GetOldestUndeployedCommit() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestUndeployedCommit
+	}
+	return nil
+}
+
+func (x *ServiceStaleness) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//*
+// Represents the staleness of every service in the contour and the worst of them
+type ContourStaleness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services               []*ServiceStaleness    `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	MaxCommitsBehind       int32                  `protobuf:"varint,2,opt,name=max_commits_behind,json=maxCommitsBehind,proto3" json:"max_commits_behind,omitempty"`
+	MaxDeployedCommitAge   *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_deployed_commit_age,json=maxDeployedCommitAge,proto3" json:"max_deployed_commit_age,omitempty"`
+	OldestUndeployedCommit *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=oldest_undeployed_commit,json=oldestUndeployedCommit,proto3" json:"oldest_undeployed_commit,omitempty"` // Across all services
+}
+
+func (x *ContourStaleness) Reset() {
+	*x = ContourStaleness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContourStaleness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContourStaleness) ProtoMessage() {}
+
+func (x *ContourStaleness) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContourStaleness.ProtoReflect.Descriptor instead.
+func (*ContourStaleness) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ContourStaleness) GetServices() []*ServiceStaleness {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ContourStaleness) GetMaxCommitsBehind() int32 {
+	if x != nil {
+		return x.MaxCommitsBehind
+	}
+	return 0
+}
+
+func (x *ContourStaleness) GetMaxDeployedCommitAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDeployedCommitAge
+	}
+	return nil
+}
+
+func (x *ContourStaleness) GetOldestUndeployedCommit() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestUndeployedCommit
+	}
+	return nil
+}
+
+//*
+// Represents options of release notes between two contours
+type ReleaseNotesOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromContourId string             `protobuf:"bytes,1,opt,name=from_contour_id,json=fromContourId,proto3" json:"from_contour_id,omitempty"` // UUID of the contour with the changes
+	ToContourId   string             `protobuf:"bytes,2,opt,name=to_contour_id,json=toContourId,proto3" json:"to_contour_id,omitempty"`       // UUID of the contour the changes are released to
+	Format        ReleaseNotesFormat `protobuf:"varint,3,opt,name=format,proto3,enum=apps.ReleaseNotesFormat" json:"format,omitempty"`
+	ExcludeTitle  string             `protobuf:"bytes,4,opt,name=exclude_title,json=excludeTitle,proto3" json:"exclude_title,omitempty"` // Drops commits and merge requests whose titles match, e.g. ^chore
+	Limit         int32              `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Limit of commits and of merge requests per project, no limit if 0
+}
+
+func (x *ReleaseNotesOptions) Reset() {
+	*x = ReleaseNotesOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseNotesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNotesOptions) ProtoMessage() {}
+
+func (x *ReleaseNotesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNotesOptions.ProtoReflect.Descriptor instead.
+func (*ReleaseNotesOptions) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReleaseNotesOptions) GetFromContourId() string {
+	if x != nil {
+		return x.FromContourId
+	}
+	return ""
+}
+
+func (x *ReleaseNotesOptions) GetToContourId() string {
+	if x != nil {
+		return x.ToContourId
+	}
+	return ""
+}
+
+func (x *ReleaseNotesOptions) GetFormat() ReleaseNotesFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReleaseNotesFormat_RELEASE_NOTES_FORMAT_MARKDOWN_UNSPECIFIED
+}
+
+func (x *ReleaseNotesOptions) GetExcludeTitle() string {
+	if x != nil {
+		return x.ExcludeTitle
+	}
+	return ""
+}
+
+func (x *ReleaseNotesOptions) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+//*
+// Represents release notes rendered in the requested format
+type ReleaseNotesDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format  ReleaseNotesFormat `protobuf:"varint,1,opt,name=format,proto3,enum=apps.ReleaseNotesFormat" json:"format,omitempty"`
+	Content string             `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ReleaseNotesDocument) Reset() {
+	*x = ReleaseNotesDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_contours_contours_v1_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseNotesDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNotesDocument) ProtoMessage() {}
+
+func (x *ReleaseNotesDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_contours_contours_v1_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNotesDocument.ProtoReflect.Descriptor instead.
+func (*ReleaseNotesDocument) Descriptor() ([]byte, []int) {
+	return file_apps_contours_contours_v1_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReleaseNotesDocument) GetFormat() ReleaseNotesFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReleaseNotesFormat_RELEASE_NOTES_FORMAT_MARKDOWN_UNSPECIFIED
+}
+
+func (x *ReleaseNotesDocument) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+var File_apps_contours_contours_v1_proto protoreflect.FileDescriptor
+
+var file_apps_contours_contours_v1_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x04, 0x61, 0x70, 0x70, 0x73, 0x1a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x1b, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x1a, 0x43, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a,
+	0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xbf,
+	0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x22, 0x79, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x41,
+	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x22, 0xe0, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x64, 0x22, 0x94, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x68, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0x5f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74,
+	0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72,
+	0x49, 0x64, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61,
+	0x70, 0x70, 0x73, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x68,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x53, 0x68, 0x61,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x68, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x61, 0x68, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72,
+	0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x38, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x11, 0x47, 0x69,
+	0x74, 0x6c, 0x61, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x15,
+	0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x6c, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x2b, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2f, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0xce,
+	0x01, 0x0a, 0x13, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xd6, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xd2, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65,
+	0x66, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x68, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa4, 0x01,
+	0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x69, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x77,
+	0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65,
+	0x62, 0x55, 0x72, 0x6c, 0x22, 0xef, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x53, 0x68,
+	0x61, 0x12, 0x3d, 0x0a, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0d, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75,
+	0x72, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x2b, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75,
+	0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x6f,
+	0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2f, 0x0a, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x3d, 0x0a,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x52, 0x0a, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x69, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x69,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x69, 0x6e, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73,
+	0x22, 0x92, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x69, 0x6e, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x53, 0x68, 0x61,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64,
+	0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f,
+	0x73, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x64, 0x53, 0x68, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72,
+	0x50, 0x69, 0x6e, 0x73, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x69, 0x6e, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a,
+	0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22,
+	0x4a, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0c,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22,
+	0x5d, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x22, 0xc1,
+	0x01, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x45, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x12, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x69, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x62, 0x55, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e,
+	0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x57,
+	0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x53, 0x68, 0x61, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x62, 0x65, 0x68, 0x69, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x42, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x12, 0x49, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x67,
+	0x65, 0x12, 0x54, 0x0a, 0x18, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x16, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9c, 0x02,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65,
+	0x73, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x65,
+	0x68, 0x69, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x14, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x41, 0x67, 0x65, 0x12, 0x54, 0x0a, 0x18, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x75, 0x6e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x16, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xce, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e,
+	0x6f, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x62, 0x0a,
+	0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x2a, 0xa5, 0x01, 0x0a, 0x0a, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x23, 0x0a, 0x1f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x19, 0x0a, 0x15, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x44, 0x49, 0x46, 0x46, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44,
+	0x52, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f,
+	0x49, 0x4e, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x52, 0x49,
+	0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x49, 0x4e,
+	0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10, 0x04, 0x2a, 0xb6, 0x01, 0x0a, 0x11, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x26, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45,
+	0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x4e, 0x56, 0x49,
+	0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f,
+	0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x04, 0x2a, 0x65, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x1a,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f,
+	0x59, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x8d, 0x01, 0x0a, 0x0e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x23,
+	0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c,
+	0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a,
+	0x16, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x62, 0x0a, 0x12, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x2d, 0x0a, 0x29, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x53,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x53, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x32, 0x89, 0x0f,
+	0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x06, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x6f, 0x75, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x6f, 0x75, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x03, 0x47, 0x65, 0x74,
+	0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49,
+	0x64, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x4e, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x20, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x41, 0x64, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x14,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f,
+	0x75, 0x72, 0x49, 0x64, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x1a,
+	0x13, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72,
+	0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x47,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x10, 0x53, 0x74, 0x6f,
+	0x70, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0f, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x19,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a,
+	0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75,
+	0x72, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x49, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x38, 0x0a,
+	0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x12,
+	0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64,
+	0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x00, 0x12, 0x38,
+	0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x11, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x69, 0x6e, 0x73,
+	0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x50, 0x69, 0x6e, 0x73, 0x44, 0x72, 0x69, 0x66, 0x74, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49,
+	0x64, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0c, 0x53, 0x65,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79,
+	0x73, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x79, 0x6e, 0x63, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x19, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x6b,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61,
+	0x70, 0x70, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f,
+	0x75, 0x72, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x19,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12,
+	0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64,
+	0x1a, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x38, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x64, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x65, 0x6e, 0x76, 0x73, 0x70, 0x6f, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x2d, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_contours_contours_v1_proto_rawDescOnce sync.Once
+	file_apps_contours_contours_v1_proto_rawDescData = file_apps_contours_contours_v1_proto_rawDesc
+)
+
+func file_apps_contours_contours_v1_proto_rawDescGZIP() []byte {
+	file_apps_contours_contours_v1_proto_rawDescOnce.Do(func() {
+		file_apps_contours_contours_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_contours_contours_v1_proto_rawDescData)
+	})
+	return file_apps_contours_contours_v1_proto_rawDescData
+}
+
+var file_apps_contours_contours_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_apps_contours_contours_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_apps_contours_contours_v1_proto_goTypes = []interface{}{
+	(DriftState)(0),                    // 0: apps.DriftState
+	(EnvironmentAction)(0),             // 1: apps.EnvironmentAction
+	(Health)(0),                        // 2: apps.Health
+	(VariableAction)(0),                // 3: apps.VariableAction
+	(ReleaseNotesFormat)(0),            // 4: apps.ReleaseNotesFormat
+	(*ContourId)(nil),                  // 5: apps.ContourId
+	(*ContoursListOption)(nil),         // 6: apps.ContoursListOption
+	(*ContourIdAndName)(nil),           // 7: apps.ContourIdAndName
+	(*ContourInfoWithoutServices)(nil), // 8: apps.ContourInfoWithoutServices
+	(*ContourNameAndDescription)(nil),  // 9: apps.ContourNameAndDescription
+	(*ContourInfo)(nil),                // 10: apps.ContourInfo
+	(*ServiceWithoutId)(nil),           // 11: apps.ServiceWithoutId
+	(*ServiceInfo)(nil),                // 12: apps.ServiceInfo
+	(*ServiceIdAndContourId)(nil),      // 13: apps.ServiceIdAndContourId
+	(*RepeatedServiceWithoutId)(nil),   // 14: apps.RepeatedServiceWithoutId
+	(*RepeatedServiceWithId)(nil),      // 15: apps.RepeatedServiceWithId
+	(*ServiceStatus)(nil),              // 16: apps.ServiceStatus
+	(*ContourStatus)(nil),              // 17: apps.ContourStatus
+	(*ContoursToCompare)(nil),          // 18: apps.ContoursToCompare
+	(*ProjectDrift)(nil),               // 19: apps.ProjectDrift
+	(*ContoursDrift)(nil),              // 20: apps.ContoursDrift
+	(*ContoursToPromote)(nil),          // 21: apps.ContoursToPromote
+	(*PromoteStep)(nil),                // 22: apps.PromoteStep
+	(*PromoteReport)(nil),              // 23: apps.PromoteReport
+	(*GitlabGroupImport)(nil),          // 24: apps.GitlabGroupImport
+	(*ImportedService)(nil),            // 25: apps.ImportedService
+	(*ImportReport)(nil),               // 26: apps.ImportReport
+	(*EnvironmentProgress)(nil),        // 27: apps.EnvironmentProgress
+	(*DeploymentsListOptions)(nil),     // 28: apps.DeploymentsListOptions
+	(*DeploymentInfo)(nil),             // 29: apps.DeploymentInfo
+	(*MergeRequestInfo)(nil),           // 30: apps.MergeRequestInfo
+	(*ServicePendingChanges)(nil),      // 31: apps.ServicePendingChanges
+	(*ContourPendingChanges)(nil),      // 32: apps.ContourPendingChanges
+	(*ServiceHealth)(nil),              // 33: apps.ServiceHealth
+	(*ContourHealth)(nil),              // 34: apps.ContourHealth
+	(*ServicePin)(nil),                 // 35: apps.ServicePin
+	(*ServicePins)(nil),                // 36: apps.ServicePins
+	(*ServicePinDrift)(nil),            // 37: apps.ServicePinDrift
+	(*ContourPinsDrift)(nil),           // 38: apps.ContourPinsDrift
+	(*ServiceRollback)(nil),            // 39: apps.ServiceRollback
+	(*Variable)(nil),                   // 40: apps.Variable
+	(*ContourVariables)(nil),           // 41: apps.ContourVariables
+	(*VariableKeys)(nil),               // 42: apps.VariableKeys
+	(*VariablesSync)(nil),              // 43: apps.VariablesSync
+	(*VariableChange)(nil),             // 44: apps.VariableChange
+	(*VariablesSyncReport)(nil),        // 45: apps.VariablesSyncReport
+	(*MergeRequestToLink)(nil),         // 46: apps.MergeRequestToLink
+	(*LinkedMergeRequest)(nil),         // 47: apps.LinkedMergeRequest
+	(*ContourMergeRequests)(nil),       // 48: apps.ContourMergeRequests
+	(*ServiceStaleness)(nil),           // 49: apps.ServiceStaleness
+	(*ContourStaleness)(nil),           // 50: apps.ContourStaleness
+	(*ReleaseNotesOptions)(nil),        // 51: apps.ReleaseNotesOptions
+	(*ReleaseNotesDocument)(nil),       // 52: apps.ReleaseNotesDocument
+	(*timestamppb.Timestamp)(nil),      // 53: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 54: google.protobuf.Duration
+	(*common.EmptyMessage)(nil),        // 55: common.EmptyMessage
+}
+var file_apps_contours_contours_v1_proto_depIdxs = []int32{
+	12, // 0: apps.ContourInfo.services:type_name -> apps.ServiceInfo
+	2,  // 1: apps.ContourInfo.health:type_name -> apps.Health
+	11, // 2: apps.RepeatedServiceWithoutId.services:type_name -> apps.ServiceWithoutId
+	12, // 3: apps.RepeatedServiceWithId.services:type_name -> apps.ServiceInfo
+	53, // 4: apps.ServiceStatus.finished_at:type_name -> google.protobuf.Timestamp
+	53, // 5: apps.ServiceStatus.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 6: apps.ContourStatus.services:type_name -> apps.ServiceStatus
+	0,  // 7: apps.ProjectDrift.state:type_name -> apps.DriftState
+	19, // 8: apps.ContoursDrift.projects:type_name -> apps.ProjectDrift
+	22, // 9: apps.PromoteReport.steps:type_name -> apps.PromoteStep
+	25, // 10: apps.ImportReport.added:type_name -> apps.ImportedService
+	25, // 11: apps.ImportReport.skipped:type_name -> apps.ImportedService
+	1,  // 12: apps.EnvironmentProgress.action:type_name -> apps.EnvironmentAction
+	53, // 13: apps.DeploymentsListOptions.since:type_name -> google.protobuf.Timestamp
+	53, // 14: apps.DeploymentsListOptions.until:type_name -> google.protobuf.Timestamp
+	53, // 15: apps.DeploymentInfo.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 16: apps.MergeRequestInfo.merged_at:type_name -> google.protobuf.Timestamp
+	30, // 17: apps.ServicePendingChanges.merge_requests:type_name -> apps.MergeRequestInfo
+	31, // 18: apps.ContourPendingChanges.services:type_name -> apps.ServicePendingChanges
+	2,  // 19: apps.ServiceHealth.health:type_name -> apps.Health
+	2,  // 20: apps.ContourHealth.health:type_name -> apps.Health
+	33, // 21: apps.ContourHealth.services:type_name -> apps.ServiceHealth
+	35, // 22: apps.ServicePins.pins:type_name -> apps.ServicePin
+	37, // 23: apps.ContourPinsDrift.services:type_name -> apps.ServicePinDrift
+	40, // 24: apps.ContourVariables.variables:type_name -> apps.Variable
+	3,  // 25: apps.VariableChange.action:type_name -> apps.VariableAction
+	44, // 26: apps.VariablesSyncReport.changes:type_name -> apps.VariableChange
+	53, // 27: apps.LinkedMergeRequest.linked_at:type_name -> google.protobuf.Timestamp
+	47, // 28: apps.ContourMergeRequests.merge_requests:type_name -> apps.LinkedMergeRequest
+	54, // 29: apps.ServiceStaleness.deployed_commit_age:type_name -> google.protobuf.Duration
+	53, // 30: apps.ServiceStaleness.oldest_undeployed_commit:type_name -> google.protobuf.Timestamp
+	49, // 31: apps.ContourStaleness.services:type_name -> apps.ServiceStaleness
+	54, // 32: apps.ContourStaleness.max_deployed_commit_age:type_name -> google.protobuf.Duration
+	53, // 33: apps.ContourStaleness.oldest_undeployed_commit:type_name -> google.protobuf.Timestamp
+	4,  // 34: apps.ReleaseNotesOptions.format:type_name -> apps.ReleaseNotesFormat
+	4,  // 35: apps.ReleaseNotesDocument.format:type_name -> apps.ReleaseNotesFormat
+	9,  // 36: apps.Contours.Create:input_type -> apps.ContourNameAndDescription
+	5,  // 37: apps.Contours.Get:input_type -> apps.ContourId
+	6,  // 38: apps.Contours.List:input_type -> apps.ContoursListOption
+	8,  // 39: apps.Contours.Update:input_type -> apps.ContourInfoWithoutServices
+	7,  // 40: apps.Contours.Delete:input_type -> apps.ContourIdAndName
+	14, // 41: apps.Contours.AddServices:input_type -> apps.RepeatedServiceWithoutId
+	13, // 42: apps.Contours.RemoveService:input_type -> apps.ServiceIdAndContourId
+	5,  // 43: apps.Contours.GetStatus:input_type -> apps.ContourId
+	18, // 44: apps.Contours.Compare:input_type -> apps.ContoursToCompare
+	21, // 45: apps.Contours.Promote:input_type -> apps.ContoursToPromote
+	24, // 46: apps.Contours.ImportFromGitlabGroup:input_type -> apps.GitlabGroupImport
+	5,  // 47: apps.Contours.StopEnvironments:input_type -> apps.ContourId
+	5,  // 48: apps.Contours.StartEnvironments:input_type -> apps.ContourId
+	28, // 49: apps.Contours.ListDeployments:input_type -> apps.DeploymentsListOptions
+	5,  // 50: apps.Contours.RefreshContour:input_type -> apps.ContourId
+	5,  // 51: apps.Contours.PendingChanges:input_type -> apps.ContourId
+	5,  // 52: apps.Contours.GetHealth:input_type -> apps.ContourId
+	36, // 53: apps.Contours.PinServices:input_type -> apps.ServicePins
+	5,  // 54: apps.Contours.CheckDrift:input_type -> apps.ContourId
+	13, // 55: apps.Contours.RollbackService:input_type -> apps.ServiceIdAndContourId
+	41, // 56: apps.Contours.SetVariables:input_type -> apps.ContourVariables
+	42, // 57: apps.Contours.RemoveVariables:input_type -> apps.VariableKeys
+	5,  // 58: apps.Contours.ListVariables:input_type -> apps.ContourId
+	43, // 59: apps.Contours.SyncVariables:input_type -> apps.VariablesSync
+	46, // 60: apps.Contours.LinkMergeRequest:input_type -> apps.MergeRequestToLink
+	5,  // 61: apps.Contours.ListMergeRequests:input_type -> apps.ContourId
+	5,  // 62: apps.Contours.Staleness:input_type -> apps.ContourId
+	51, // 63: apps.Contours.ReleaseNotes:input_type -> apps.ReleaseNotesOptions
+	5,  // 64: apps.Contours.Lock:input_type -> apps.ContourId
+	5,  // 65: apps.Contours.Unlock:input_type -> apps.ContourId
+	8,  // 66: apps.Contours.Create:output_type -> apps.ContourInfoWithoutServices
+	10, // 67: apps.Contours.Get:output_type -> apps.ContourInfo
+	10, // 68: apps.Contours.List:output_type -> apps.ContourInfo
+	8,  // 69: apps.Contours.Update:output_type -> apps.ContourInfoWithoutServices
+	55, // 70: apps.Contours.Delete:output_type -> common.EmptyMessage
+	55, // 71: apps.Contours.AddServices:output_type -> common.EmptyMessage
+	55, // 72: apps.Contours.RemoveService:output_type -> common.EmptyMessage
+	17, // 73: apps.Contours.GetStatus:output_type -> apps.ContourStatus
+	20, // 74: apps.Contours.Compare:output_type -> apps.ContoursDrift
+	23, // 75: apps.Contours.Promote:output_type -> apps.PromoteReport
+	26, // 76: apps.Contours.ImportFromGitlabGroup:output_type -> apps.ImportReport
+	27, // 77: apps.Contours.StopEnvironments:output_type -> apps.EnvironmentProgress
+	27, // 78: apps.Contours.StartEnvironments:output_type -> apps.EnvironmentProgress
+	29, // 79: apps.Contours.ListDeployments:output_type -> apps.DeploymentInfo
+	17, // 80: apps.Contours.RefreshContour:output_type -> apps.ContourStatus
+	32, // 81: apps.Contours.PendingChanges:output_type -> apps.ContourPendingChanges
+	34, // 82: apps.Contours.GetHealth:output_type -> apps.ContourHealth
+	55, // 83: apps.Contours.PinServices:output_type -> common.EmptyMessage
+	38, // 84: apps.Contours.CheckDrift:output_type -> apps.ContourPinsDrift
+	39, // 85: apps.Contours.RollbackService:output_type -> apps.ServiceRollback
+	55, // 86: apps.Contours.SetVariables:output_type -> common.EmptyMessage
+	55, // 87: apps.Contours.RemoveVariables:output_type -> common.EmptyMessage
+	41, // 88: apps.Contours.ListVariables:output_type -> apps.ContourVariables
+	45, // 89: apps.Contours.SyncVariables:output_type -> apps.VariablesSyncReport
+	47, // 90: apps.Contours.LinkMergeRequest:output_type -> apps.LinkedMergeRequest
+	48, // 91: apps.Contours.ListMergeRequests:output_type -> apps.ContourMergeRequests
+	50, // 92: apps.Contours.Staleness:output_type -> apps.ContourStaleness
+	52, // 93: apps.Contours.ReleaseNotes:output_type -> apps.ReleaseNotesDocument
+	27, // 94: apps.Contours.Lock:output_type -> apps.EnvironmentProgress
+	27, // 95: apps.Contours.Unlock:output_type -> apps.EnvironmentProgress
+	66, // [66:96] is the sub-list for method output_type
+	36, // [36:66] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_apps_contours_contours_v1_proto_init() }
+func file_apps_contours_contours_v1_proto_init() {
+	if File_apps_contours_contours_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_contours_contours_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContoursListOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourIdAndName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourInfoWithoutServices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourNameAndDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceWithoutId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceIdAndContourId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedServiceWithoutId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedServiceWithId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContoursToCompare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContoursDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContoursToPromote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitlabGroupImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportedService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploymentsListOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploymentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeRequestInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePendingChanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourPendingChanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePinDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourPinsDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRollback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourVariables); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariableKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariablesSync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariableChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariablesSyncReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeRequestToLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkedMergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourMergeRequests); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStaleness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContourStaleness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseNotesOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_contours_contours_v1_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseNotesDocument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_contours_contours_v1_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   48,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apps_contours_contours_v1_proto_goTypes,
+		DependencyIndexes: file_apps_contours_contours_v1_proto_depIdxs,
+		EnumInfos:         file_apps_contours_contours_v1_proto_enumTypes,
+		MessageInfos:      file_apps_contours_contours_v1_proto_msgTypes,
+	}.Build()
+	File_apps_contours_contours_v1_proto = out.File
+	file_apps_contours_contours_v1_proto_rawDesc = nil
+	file_apps_contours_contours_v1_proto_goTypes = nil
+	file_apps_contours_contours_v1_proto_depIdxs = nil
+}
@@ -0,0 +1,1369 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package contours
+
+import (
+	context "context"
+	common "github.com/badhouseplants/envspotting-go-proto/models/common"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ContoursClient is the client API for Contours service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ContoursClient interface {
+	/// Use to add a whole new app
+	Create(ctx context.Context, in *ContourNameAndDescription, opts ...grpc.CallOption) (*ContourInfoWithoutServices, error)
+	/// Use to get app by ProjectID
+	Get(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourInfo, error)
+	/// Use to list all apps in db
+	List(ctx context.Context, in *ContoursListOption, opts ...grpc.CallOption) (Contours_ListClient, error)
+	/// Use to update an app (add/edit contour, edit name)
+	Update(ctx context.Context, in *ContourInfoWithoutServices, opts ...grpc.CallOption) (*ContourInfoWithoutServices, error)
+	/// Use to delete an app (❗️not implemented yet❗️)
+	Delete(ctx context.Context, in *ContourIdAndName, opts ...grpc.CallOption) (*common.EmptyMessage, error)
+	/// Use to add a services to the contour
+	AddServices(ctx context.Context, in *RepeatedServiceWithoutId, opts ...grpc.CallOption) (*common.EmptyMessage, error)
+	/// Use to rmove services from the contour
+	RemoveService(ctx context.Context, in *ServiceIdAndContourId, opts ...grpc.CallOption) (*common.EmptyMessage, error)
+	/// Use to get the latest deployment of every service in the contour
+	GetStatus(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourStatus, error)
+	/// Use to compare versions deployed in two contours
+	Compare(ctx context.Context, in *ContoursToCompare, opts ...grpc.CallOption) (*ContoursDrift, error)
+	/// Use to deploy versions of one contour into another one
+	Promote(ctx context.Context, in *ContoursToPromote, opts ...grpc.CallOption) (*PromoteReport, error)
+	/// Use to add every project of a gitlab group with a matching environment to the contour
+	ImportFromGitlabGroup(ctx context.Context, in *GitlabGroupImport, opts ...grpc.CallOption) (*ImportReport, error)
+	/// Use to stop environments of every service in the contour
+	StopEnvironments(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_StopEnvironmentsClient, error)
+	/// Use to start environments of every service in the contour again
+	StartEnvironments(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_StartEnvironmentsClient, error)
+	/// Use to get the deployment history of the contour newest first
+	ListDeployments(ctx context.Context, in *DeploymentsListOptions, opts ...grpc.CallOption) (Contours_ListDeploymentsClient, error)
+	/// Use to refresh deployments of every service in the contour right away
+	RefreshContour(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourStatus, error)
+	/// Use to get merge requests merged but not yet deployed to the contour
+	PendingChanges(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourPendingChanges, error)
+	/// Use to get the health of every service in the contour
+	GetHealth(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourHealth, error)
+	/// Use to pin contour services to tags, branches or shas, an empty ref removes the pin
+	PinServices(ctx context.Context, in *ServicePins, opts ...grpc.CallOption) (*common.EmptyMessage, error)
+	/// Use to compare pinned refs of contour services with their actual deployments
+	CheckDrift(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourPinsDrift, error)
+	/// Use to roll a service back to its previous successful deployment
+	RollbackService(ctx context.Context, in *ServiceIdAndContourId, opts ...grpc.CallOption) (*ServiceRollback, error)
+	/// Use to set variables of the contour, existing keys are overwritten
+	SetVariables(ctx context.Context, in *ContourVariables, opts ...grpc.CallOption) (*common.EmptyMessage, error)
+	/// Use to remove variables of the contour, they stay in gitlab until a sync with prune
+	RemoveVariables(ctx context.Context, in *VariableKeys, opts ...grpc.CallOption) (*common.EmptyMessage, error)
+	/// Use to list variables of the contour, values of masked ones are left empty
+	ListVariables(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourVariables, error)
+	/// Use to sync variables of the contour into environments of its services
+	SyncVariables(ctx context.Context, in *VariablesSync, opts ...grpc.CallOption) (*VariablesSyncReport, error)
+	/// Use to link a merge request to the contour and comment on it with services of the contour
+	LinkMergeRequest(ctx context.Context, in *MergeRequestToLink, opts ...grpc.CallOption) (*LinkedMergeRequest, error)
+	/// Use to list merge requests linked to the contour
+	ListMergeRequests(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourMergeRequests, error)
+	/// Use to find how far services of the contour are behind default branches of their projects
+	Staleness(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourStaleness, error)
+	/// Use to get release notes of everything deployed in one contour but not yet in another one
+	ReleaseNotes(ctx context.Context, in *ReleaseNotesOptions, opts ...grpc.CallOption) (*ReleaseNotesDocument, error)
+	/// Use to let only the lock group of the gitlab connection deploy to environments of the contour
+	Lock(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_LockClient, error)
+	/// Use to restore the protection environments of the contour had before the lock
+	Unlock(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_UnlockClient, error)
+}
+
+type contoursClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContoursClient(cc grpc.ClientConnInterface) ContoursClient {
+	return &contoursClient{cc}
+}
+
+func (c *contoursClient) Create(ctx context.Context, in *ContourNameAndDescription, opts ...grpc.CallOption) (*ContourInfoWithoutServices, error) {
+	out := new(ContourInfoWithoutServices)
+	err := c.cc.Invoke(ctx, "/apps.Contours/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) Get(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourInfo, error) {
+	out := new(ContourInfo)
+	err := c.cc.Invoke(ctx, "/apps.Contours/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) List(ctx context.Context, in *ContoursListOption, opts ...grpc.CallOption) (Contours_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Contours_ServiceDesc.Streams[0], "/apps.Contours/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contoursListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contours_ListClient interface {
+	Recv() (*ContourInfo, error)
+	grpc.ClientStream
+}
+
+type contoursListClient struct {
+	grpc.ClientStream
+}
+
+func (x *contoursListClient) Recv() (*ContourInfo, error) {
+	m := new(ContourInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contoursClient) Update(ctx context.Context, in *ContourInfoWithoutServices, opts ...grpc.CallOption) (*ContourInfoWithoutServices, error) {
+	out := new(ContourInfoWithoutServices)
+	err := c.cc.Invoke(ctx, "/apps.Contours/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) Delete(ctx context.Context, in *ContourIdAndName, opts ...grpc.CallOption) (*common.EmptyMessage, error) {
+	out := new(common.EmptyMessage)
+	err := c.cc.Invoke(ctx, "/apps.Contours/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) AddServices(ctx context.Context, in *RepeatedServiceWithoutId, opts ...grpc.CallOption) (*common.EmptyMessage, error) {
+	out := new(common.EmptyMessage)
+	err := c.cc.Invoke(ctx, "/apps.Contours/AddServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) RemoveService(ctx context.Context, in *ServiceIdAndContourId, opts ...grpc.CallOption) (*common.EmptyMessage, error) {
+	out := new(common.EmptyMessage)
+	err := c.cc.Invoke(ctx, "/apps.Contours/RemoveService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) GetStatus(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourStatus, error) {
+	out := new(ContourStatus)
+	err := c.cc.Invoke(ctx, "/apps.Contours/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) Compare(ctx context.Context, in *ContoursToCompare, opts ...grpc.CallOption) (*ContoursDrift, error) {
+	out := new(ContoursDrift)
+	err := c.cc.Invoke(ctx, "/apps.Contours/Compare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) Promote(ctx context.Context, in *ContoursToPromote, opts ...grpc.CallOption) (*PromoteReport, error) {
+	out := new(PromoteReport)
+	err := c.cc.Invoke(ctx, "/apps.Contours/Promote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) ImportFromGitlabGroup(ctx context.Context, in *GitlabGroupImport, opts ...grpc.CallOption) (*ImportReport, error) {
+	out := new(ImportReport)
+	err := c.cc.Invoke(ctx, "/apps.Contours/ImportFromGitlabGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) StopEnvironments(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_StopEnvironmentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Contours_ServiceDesc.Streams[1], "/apps.Contours/StopEnvironments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contoursStopEnvironmentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contours_StopEnvironmentsClient interface {
+	Recv() (*EnvironmentProgress, error)
+	grpc.ClientStream
+}
+
+type contoursStopEnvironmentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *contoursStopEnvironmentsClient) Recv() (*EnvironmentProgress, error) {
+	m := new(EnvironmentProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contoursClient) StartEnvironments(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_StartEnvironmentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Contours_ServiceDesc.Streams[2], "/apps.Contours/StartEnvironments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contoursStartEnvironmentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contours_StartEnvironmentsClient interface {
+	Recv() (*EnvironmentProgress, error)
+	grpc.ClientStream
+}
+
+type contoursStartEnvironmentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *contoursStartEnvironmentsClient) Recv() (*EnvironmentProgress, error) {
+	m := new(EnvironmentProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contoursClient) ListDeployments(ctx context.Context, in *DeploymentsListOptions, opts ...grpc.CallOption) (Contours_ListDeploymentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Contours_ServiceDesc.Streams[3], "/apps.Contours/ListDeployments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contoursListDeploymentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contours_ListDeploymentsClient interface {
+	Recv() (*DeploymentInfo, error)
+	grpc.ClientStream
+}
+
+type contoursListDeploymentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *contoursListDeploymentsClient) Recv() (*DeploymentInfo, error) {
+	m := new(DeploymentInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contoursClient) RefreshContour(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourStatus, error) {
+	out := new(ContourStatus)
+	err := c.cc.Invoke(ctx, "/apps.Contours/RefreshContour", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) PendingChanges(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourPendingChanges, error) {
+	out := new(ContourPendingChanges)
+	err := c.cc.Invoke(ctx, "/apps.Contours/PendingChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) GetHealth(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourHealth, error) {
+	out := new(ContourHealth)
+	err := c.cc.Invoke(ctx, "/apps.Contours/GetHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) PinServices(ctx context.Context, in *ServicePins, opts ...grpc.CallOption) (*common.EmptyMessage, error) {
+	out := new(common.EmptyMessage)
+	err := c.cc.Invoke(ctx, "/apps.Contours/PinServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) CheckDrift(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourPinsDrift, error) {
+	out := new(ContourPinsDrift)
+	err := c.cc.Invoke(ctx, "/apps.Contours/CheckDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) RollbackService(ctx context.Context, in *ServiceIdAndContourId, opts ...grpc.CallOption) (*ServiceRollback, error) {
+	out := new(ServiceRollback)
+	err := c.cc.Invoke(ctx, "/apps.Contours/RollbackService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) SetVariables(ctx context.Context, in *ContourVariables, opts ...grpc.CallOption) (*common.EmptyMessage, error) {
+	out := new(common.EmptyMessage)
+	err := c.cc.Invoke(ctx, "/apps.Contours/SetVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) RemoveVariables(ctx context.Context, in *VariableKeys, opts ...grpc.CallOption) (*common.EmptyMessage, error) {
+	out := new(common.EmptyMessage)
+	err := c.cc.Invoke(ctx, "/apps.Contours/RemoveVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) ListVariables(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourVariables, error) {
+	out := new(ContourVariables)
+	err := c.cc.Invoke(ctx, "/apps.Contours/ListVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) SyncVariables(ctx context.Context, in *VariablesSync, opts ...grpc.CallOption) (*VariablesSyncReport, error) {
+	out := new(VariablesSyncReport)
+	err := c.cc.Invoke(ctx, "/apps.Contours/SyncVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) LinkMergeRequest(ctx context.Context, in *MergeRequestToLink, opts ...grpc.CallOption) (*LinkedMergeRequest, error) {
+	out := new(LinkedMergeRequest)
+	err := c.cc.Invoke(ctx, "/apps.Contours/LinkMergeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) ListMergeRequests(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourMergeRequests, error) {
+	out := new(ContourMergeRequests)
+	err := c.cc.Invoke(ctx, "/apps.Contours/ListMergeRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) Staleness(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (*ContourStaleness, error) {
+	out := new(ContourStaleness)
+	err := c.cc.Invoke(ctx, "/apps.Contours/Staleness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) ReleaseNotes(ctx context.Context, in *ReleaseNotesOptions, opts ...grpc.CallOption) (*ReleaseNotesDocument, error) {
+	out := new(ReleaseNotesDocument)
+	err := c.cc.Invoke(ctx, "/apps.Contours/ReleaseNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contoursClient) Lock(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_LockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Contours_ServiceDesc.Streams[4], "/apps.Contours/Lock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contoursLockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contours_LockClient interface {
+	Recv() (*EnvironmentProgress, error)
+	grpc.ClientStream
+}
+
+type contoursLockClient struct {
+	grpc.ClientStream
+}
+
+func (x *contoursLockClient) Recv() (*EnvironmentProgress, error) {
+	m := new(EnvironmentProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contoursClient) Unlock(ctx context.Context, in *ContourId, opts ...grpc.CallOption) (Contours_UnlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Contours_ServiceDesc.Streams[5], "/apps.Contours/Unlock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contoursUnlockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contours_UnlockClient interface {
+	Recv() (*EnvironmentProgress, error)
+	grpc.ClientStream
+}
+
+type contoursUnlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *contoursUnlockClient) Recv() (*EnvironmentProgress, error) {
+	m := new(EnvironmentProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ContoursServer is the server API for Contours service.
+// All implementations must embed UnimplementedContoursServer
+// for forward compatibility
+type ContoursServer interface {
+	/// Use to add a whole new app
+	Create(context.Context, *ContourNameAndDescription) (*ContourInfoWithoutServices, error)
+	/// Use to get app by ProjectID
+	Get(context.Context, *ContourId) (*ContourInfo, error)
+	/// Use to list all apps in db
+	List(*ContoursListOption, Contours_ListServer) error
+	/// Use to update an app (add/edit contour, edit name)
+	Update(context.Context, *ContourInfoWithoutServices) (*ContourInfoWithoutServices, error)
+	/// Use to delete an app (❗️not implemented yet❗️)
+	Delete(context.Context, *ContourIdAndName) (*common.EmptyMessage, error)
+	/// Use to add a services to the contour
+	AddServices(context.Context, *RepeatedServiceWithoutId) (*common.EmptyMessage, error)
+	/// Use to rmove services from the contour
+	RemoveService(context.Context, *ServiceIdAndContourId) (*common.EmptyMessage, error)
+	/// Use to get the latest deployment of every service in the contour
+	GetStatus(context.Context, *ContourId) (*ContourStatus, error)
+	/// Use to compare versions deployed in two contours
+	Compare(context.Context, *ContoursToCompare) (*ContoursDrift, error)
+	/// Use to deploy versions of one contour into another one
+	Promote(context.Context, *ContoursToPromote) (*PromoteReport, error)
+	/// Use to add every project of a gitlab group with a matching environment to the contour
+	ImportFromGitlabGroup(context.Context, *GitlabGroupImport) (*ImportReport, error)
+	/// Use to stop environments of every service in the contour
+	StopEnvironments(*ContourId, Contours_StopEnvironmentsServer) error
+	/// Use to start environments of every service in the contour again
+	StartEnvironments(*ContourId, Contours_StartEnvironmentsServer) error
+	/// Use to get the deployment history of the contour newest first
+	ListDeployments(*DeploymentsListOptions, Contours_ListDeploymentsServer) error
+	/// Use to refresh deployments of every service in the contour right away
+	RefreshContour(context.Context, *ContourId) (*ContourStatus, error)
+	/// Use to get merge requests merged but not yet deployed to the contour
+	PendingChanges(context.Context, *ContourId) (*ContourPendingChanges, error)
+	/// Use to get the health of every service in the contour
+	GetHealth(context.Context, *ContourId) (*ContourHealth, error)
+	/// Use to pin contour services to tags, branches or shas, an empty ref removes the pin
+	PinServices(context.Context, *ServicePins) (*common.EmptyMessage, error)
+	/// Use to compare pinned refs of contour services with their actual deployments
+	CheckDrift(context.Context, *ContourId) (*ContourPinsDrift, error)
+	/// Use to roll a service back to its previous successful deployment
+	RollbackService(context.Context, *ServiceIdAndContourId) (*ServiceRollback, error)
+	/// Use to set variables of the contour, existing keys are overwritten
+	SetVariables(context.Context, *ContourVariables) (*common.EmptyMessage, error)
+	/// Use to remove variables of the contour, they stay in gitlab until a sync with prune
+	RemoveVariables(context.Context, *VariableKeys) (*common.EmptyMessage, error)
+	/// Use to list variables of the contour, values of masked ones are left empty
+	ListVariables(context.Context, *ContourId) (*ContourVariables, error)
+	/// Use to sync variables of the contour into environments of its services
+	SyncVariables(context.Context, *VariablesSync) (*VariablesSyncReport, error)
+	/// Use to link a merge request to the contour and comment on it with services of the contour
+	LinkMergeRequest(context.Context, *MergeRequestToLink) (*LinkedMergeRequest, error)
+	/// Use to list merge requests linked to the contour
+	ListMergeRequests(context.Context, *ContourId) (*ContourMergeRequests, error)
+	/// Use to find how far services of the contour are behind default branches of their projects
+	Staleness(context.Context, *ContourId) (*ContourStaleness, error)
+	/// Use to get release notes of everything deployed in one contour but not yet in another one
+	ReleaseNotes(context.Context, *ReleaseNotesOptions) (*ReleaseNotesDocument, error)
+	/// Use to let only the lock group of the gitlab connection deploy to environments of the contour
+	Lock(*ContourId, Contours_LockServer) error
+	/// Use to restore the protection environments of the contour had before the lock
+	Unlock(*ContourId, Contours_UnlockServer) error
+	mustEmbedUnimplementedContoursServer()
+}
+
+// UnimplementedContoursServer must be embedded to have forward compatible implementations.
+type UnimplementedContoursServer struct {
+}
+
+func (UnimplementedContoursServer) Create(context.Context, *ContourNameAndDescription) (*ContourInfoWithoutServices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedContoursServer) Get(context.Context, *ContourId) (*ContourInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedContoursServer) List(*ContoursListOption, Contours_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedContoursServer) Update(context.Context, *ContourInfoWithoutServices) (*ContourInfoWithoutServices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedContoursServer) Delete(context.Context, *ContourIdAndName) (*common.EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedContoursServer) AddServices(context.Context, *RepeatedServiceWithoutId) (*common.EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddServices not implemented")
+}
+func (UnimplementedContoursServer) RemoveService(context.Context, *ServiceIdAndContourId) (*common.EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+}
+func (UnimplementedContoursServer) GetStatus(context.Context, *ContourId) (*ContourStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedContoursServer) Compare(context.Context, *ContoursToCompare) (*ContoursDrift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compare not implemented")
+}
+func (UnimplementedContoursServer) Promote(context.Context, *ContoursToPromote) (*PromoteReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (UnimplementedContoursServer) ImportFromGitlabGroup(context.Context, *GitlabGroupImport) (*ImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromGitlabGroup not implemented")
+}
+func (UnimplementedContoursServer) StopEnvironments(*ContourId, Contours_StopEnvironmentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StopEnvironments not implemented")
+}
+func (UnimplementedContoursServer) StartEnvironments(*ContourId, Contours_StartEnvironmentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StartEnvironments not implemented")
+}
+func (UnimplementedContoursServer) ListDeployments(*DeploymentsListOptions, Contours_ListDeploymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
+}
+func (UnimplementedContoursServer) RefreshContour(context.Context, *ContourId) (*ContourStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshContour not implemented")
+}
+func (UnimplementedContoursServer) PendingChanges(context.Context, *ContourId) (*ContourPendingChanges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingChanges not implemented")
+}
+func (UnimplementedContoursServer) GetHealth(context.Context, *ContourId) (*ContourHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
+func (UnimplementedContoursServer) PinServices(context.Context, *ServicePins) (*common.EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinServices not implemented")
+}
+func (UnimplementedContoursServer) CheckDrift(context.Context, *ContourId) (*ContourPinsDrift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDrift not implemented")
+}
+func (UnimplementedContoursServer) RollbackService(context.Context, *ServiceIdAndContourId) (*ServiceRollback, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackService not implemented")
+}
+func (UnimplementedContoursServer) SetVariables(context.Context, *ContourVariables) (*common.EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVariables not implemented")
+}
+func (UnimplementedContoursServer) RemoveVariables(context.Context, *VariableKeys) (*common.EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVariables not implemented")
+}
+func (UnimplementedContoursServer) ListVariables(context.Context, *ContourId) (*ContourVariables, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVariables not implemented")
+}
+func (UnimplementedContoursServer) SyncVariables(context.Context, *VariablesSync) (*VariablesSyncReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncVariables not implemented")
+}
+func (UnimplementedContoursServer) LinkMergeRequest(context.Context, *MergeRequestToLink) (*LinkedMergeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkMergeRequest not implemented")
+}
+func (UnimplementedContoursServer) ListMergeRequests(context.Context, *ContourId) (*ContourMergeRequests, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMergeRequests not implemented")
+}
+func (UnimplementedContoursServer) Staleness(context.Context, *ContourId) (*ContourStaleness, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Staleness not implemented")
+}
+func (UnimplementedContoursServer) ReleaseNotes(context.Context, *ReleaseNotesOptions) (*ReleaseNotesDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNotes not implemented")
+}
+func (UnimplementedContoursServer) Lock(*ContourId, Contours_LockServer) error {
+	return status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedContoursServer) Unlock(*ContourId, Contours_UnlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedContoursServer) mustEmbedUnimplementedContoursServer() {}
+
+// UnsafeContoursServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContoursServer will
+// result in compilation errors.
+type UnsafeContoursServer interface {
+	mustEmbedUnimplementedContoursServer()
+}
+
+func RegisterContoursServer(s grpc.ServiceRegistrar, srv ContoursServer) {
+	s.RegisterService(&Contours_ServiceDesc, srv)
+}
+
+func _Contours_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourNameAndDescription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).Create(ctx, req.(*ContourNameAndDescription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).Get(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContoursListOption)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContoursServer).List(m, &contoursListServer{stream})
+}
+
+type Contours_ListServer interface {
+	Send(*ContourInfo) error
+	grpc.ServerStream
+}
+
+type contoursListServer struct {
+	grpc.ServerStream
+}
+
+func (x *contoursListServer) Send(m *ContourInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Contours_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourInfoWithoutServices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).Update(ctx, req.(*ContourInfoWithoutServices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourIdAndName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).Delete(ctx, req.(*ContourIdAndName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_AddServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepeatedServiceWithoutId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).AddServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/AddServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).AddServices(ctx, req.(*RepeatedServiceWithoutId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceIdAndContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).RemoveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/RemoveService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).RemoveService(ctx, req.(*ServiceIdAndContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).GetStatus(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_Compare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContoursToCompare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).Compare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/Compare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).Compare(ctx, req.(*ContoursToCompare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContoursToPromote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).Promote(ctx, req.(*ContoursToPromote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_ImportFromGitlabGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GitlabGroupImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).ImportFromGitlabGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/ImportFromGitlabGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).ImportFromGitlabGroup(ctx, req.(*GitlabGroupImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_StopEnvironments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContourId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContoursServer).StopEnvironments(m, &contoursStopEnvironmentsServer{stream})
+}
+
+type Contours_StopEnvironmentsServer interface {
+	Send(*EnvironmentProgress) error
+	grpc.ServerStream
+}
+
+type contoursStopEnvironmentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *contoursStopEnvironmentsServer) Send(m *EnvironmentProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Contours_StartEnvironments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContourId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContoursServer).StartEnvironments(m, &contoursStartEnvironmentsServer{stream})
+}
+
+type Contours_StartEnvironmentsServer interface {
+	Send(*EnvironmentProgress) error
+	grpc.ServerStream
+}
+
+type contoursStartEnvironmentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *contoursStartEnvironmentsServer) Send(m *EnvironmentProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Contours_ListDeployments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeploymentsListOptions)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContoursServer).ListDeployments(m, &contoursListDeploymentsServer{stream})
+}
+
+type Contours_ListDeploymentsServer interface {
+	Send(*DeploymentInfo) error
+	grpc.ServerStream
+}
+
+type contoursListDeploymentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *contoursListDeploymentsServer) Send(m *DeploymentInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Contours_RefreshContour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).RefreshContour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/RefreshContour",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).RefreshContour(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_PendingChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).PendingChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/PendingChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).PendingChanges(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/GetHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).GetHealth(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_PinServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).PinServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/PinServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).PinServices(ctx, req.(*ServicePins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_CheckDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).CheckDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/CheckDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).CheckDrift(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_RollbackService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceIdAndContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).RollbackService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/RollbackService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).RollbackService(ctx, req.(*ServiceIdAndContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_SetVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourVariables)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).SetVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/SetVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).SetVariables(ctx, req.(*ContourVariables))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_RemoveVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariableKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).RemoveVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/RemoveVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).RemoveVariables(ctx, req.(*VariableKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_ListVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).ListVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/ListVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).ListVariables(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_SyncVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariablesSync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).SyncVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/SyncVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).SyncVariables(ctx, req.(*VariablesSync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_LinkMergeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRequestToLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).LinkMergeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/LinkMergeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).LinkMergeRequest(ctx, req.(*MergeRequestToLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_ListMergeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).ListMergeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/ListMergeRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).ListMergeRequests(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_Staleness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContourId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).Staleness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/Staleness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).Staleness(ctx, req.(*ContourId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_ReleaseNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseNotesOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContoursServer).ReleaseNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apps.Contours/ReleaseNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContoursServer).ReleaseNotes(ctx, req.(*ReleaseNotesOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contours_Lock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContourId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContoursServer).Lock(m, &contoursLockServer{stream})
+}
+
+type Contours_LockServer interface {
+	Send(*EnvironmentProgress) error
+	grpc.ServerStream
+}
+
+type contoursLockServer struct {
+	grpc.ServerStream
+}
+
+func (x *contoursLockServer) Send(m *EnvironmentProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Contours_Unlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContourId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContoursServer).Unlock(m, &contoursUnlockServer{stream})
+}
+
+type Contours_UnlockServer interface {
+	Send(*EnvironmentProgress) error
+	grpc.ServerStream
+}
+
+type contoursUnlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *contoursUnlockServer) Send(m *EnvironmentProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Contours_ServiceDesc is the grpc.ServiceDesc for Contours service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Contours_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "apps.Contours",
+	HandlerType: (*ContoursServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Contours_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Contours_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Contours_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Contours_Delete_Handler,
+		},
+		{
+			MethodName: "AddServices",
+			Handler:    _Contours_AddServices_Handler,
+		},
+		{
+			MethodName: "RemoveService",
+			Handler:    _Contours_RemoveService_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _Contours_GetStatus_Handler,
+		},
+		{
+			MethodName: "Compare",
+			Handler:    _Contours_Compare_Handler,
+		},
+		{
+			MethodName: "Promote",
+			Handler:    _Contours_Promote_Handler,
+		},
+		{
+			MethodName: "ImportFromGitlabGroup",
+			Handler:    _Contours_ImportFromGitlabGroup_Handler,
+		},
+		{
+			MethodName: "RefreshContour",
+			Handler:    _Contours_RefreshContour_Handler,
+		},
+		{
+			MethodName: "PendingChanges",
+			Handler:    _Contours_PendingChanges_Handler,
+		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _Contours_GetHealth_Handler,
+		},
+		{
+			MethodName: "PinServices",
+			Handler:    _Contours_PinServices_Handler,
+		},
+		{
+			MethodName: "CheckDrift",
+			Handler:    _Contours_CheckDrift_Handler,
+		},
+		{
+			MethodName: "RollbackService",
+			Handler:    _Contours_RollbackService_Handler,
+		},
+		{
+			MethodName: "SetVariables",
+			Handler:    _Contours_SetVariables_Handler,
+		},
+		{
+			MethodName: "RemoveVariables",
+			Handler:    _Contours_RemoveVariables_Handler,
+		},
+		{
+			MethodName: "ListVariables",
+			Handler:    _Contours_ListVariables_Handler,
+		},
+		{
+			MethodName: "SyncVariables",
+			Handler:    _Contours_SyncVariables_Handler,
+		},
+		{
+			MethodName: "LinkMergeRequest",
+			Handler:    _Contours_LinkMergeRequest_Handler,
+		},
+		{
+			MethodName: "ListMergeRequests",
+			Handler:    _Contours_ListMergeRequests_Handler,
+		},
+		{
+			MethodName: "Staleness",
+			Handler:    _Contours_Staleness_Handler,
+		},
+		{
+			MethodName: "ReleaseNotes",
+			Handler:    _Contours_ReleaseNotes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _Contours_List_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StopEnvironments",
+			Handler:       _Contours_StopEnvironments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StartEnvironments",
+			Handler:       _Contours_StartEnvironments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDeployments",
+			Handler:       _Contours_ListDeployments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Lock",
+			Handler:       _Contours_Lock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Unlock",
+			Handler:       _Contours_Unlock_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "apps/contours/contours_v1.proto",
+}
@@ -0,0 +1,408 @@
+/// This file has messages for describing gitlab environments
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.7
+// source: external/gitlab/environments/environments_v1.proto
+
+package environments
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+//*
+// Represents a Gitlab Environment ID
+type EnvironmentID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId int64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Gitlab Project ID
+	Id        int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                                // Gitlab Environment Id
+}
+
+func (x *EnvironmentID) Reset() {
+	*x = EnvironmentID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_external_gitlab_environments_environments_v1_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvironmentID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentID) ProtoMessage() {}
+
+func (x *EnvironmentID) ProtoReflect() protoreflect.Message {
+	mi := &file_external_gitlab_environments_environments_v1_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentID.ProtoReflect.Descriptor instead.
+func (*EnvironmentID) Descriptor() ([]byte, []int) {
+	return file_external_gitlab_environments_environments_v1_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnvironmentID) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *EnvironmentID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+//*
+// Represents a Gitlab Environment name
+type EnvironmentName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId int64  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Gitlab Project ID
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                             // Gitlab Environment name
+}
+
+func (x *EnvironmentName) Reset() {
+	*x = EnvironmentName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_external_gitlab_environments_environments_v1_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvironmentName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentName) ProtoMessage() {}
+
+func (x *EnvironmentName) ProtoReflect() protoreflect.Message {
+	mi := &file_external_gitlab_environments_environments_v1_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentName.ProtoReflect.Descriptor instead.
+func (*EnvironmentName) Descriptor() ([]byte, []int) {
+	return file_external_gitlab_environments_environments_v1_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnvironmentName) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *EnvironmentName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//*
+// Represents a Gitlab Environment
+type EnvironmentInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // Gitlab Environment ID
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                             // Gitlab Environment name
+	State     string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                           // Gitlab Environment state
+	Url       string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                               // Gitlab Environment external URL
+	Ref       string `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`                               // Gitlab Environment commit
+	Sha       string `protobuf:"bytes,6,opt,name=sha,proto3" json:"sha,omitempty"`                               // Gitlab Environment commit SHA
+	CiStatus  string `protobuf:"bytes,7,opt,name=ci_status,json=ciStatus,proto3" json:"ci_status,omitempty"`     // Gitlab Environment Gitlab-CI status
+	CiId      int64  `protobuf:"varint,8,opt,name=ci_id,json=ciId,proto3" json:"ci_id,omitempty"`                // Gitlab Environment Gitlab-CI ID
+	UserId    int64  `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // Gitlab Environment Gitlab-CI trigger user ID
+	UserName  string `protobuf:"bytes,10,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`    // Gitlab Environment Gitlab-CI trigger user name
+	UpdatedAt string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Gitlab Environment update time
+}
+
+func (x *EnvironmentInfo) Reset() {
+	*x = EnvironmentInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_external_gitlab_environments_environments_v1_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvironmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentInfo) ProtoMessage() {}
+
+func (x *EnvironmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_external_gitlab_environments_environments_v1_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentInfo.ProtoReflect.Descriptor instead.
+func (*EnvironmentInfo) Descriptor() ([]byte, []int) {
+	return file_external_gitlab_environments_environments_v1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnvironmentInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EnvironmentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvironmentInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *EnvironmentInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EnvironmentInfo) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *EnvironmentInfo) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *EnvironmentInfo) GetCiStatus() string {
+	if x != nil {
+		return x.CiStatus
+	}
+	return ""
+}
+
+func (x *EnvironmentInfo) GetCiId() int64 {
+	if x != nil {
+		return x.CiId
+	}
+	return 0
+}
+
+func (x *EnvironmentInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EnvironmentInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *EnvironmentInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+var File_external_gitlab_environments_environments_v1_proto protoreflect.FileDescriptor
+
+var file_external_gitlab_environments_environments_v1_proto_rawDesc = []byte{
+	0x0a, 0x32, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x22, 0x3e, 0x0a, 0x0d,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x0f,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x69, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x63, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x63, 0x69, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0x85, 0x01,
+	0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37,
+	0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x17, 0x2e, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x17, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x30, 0x01, 0x42, 0x54, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x64, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x73, 0x2f, 0x65, 0x6e, 0x76, 0x73, 0x70, 0x6f, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2d, 0x67,
+	0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2f, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_external_gitlab_environments_environments_v1_proto_rawDescOnce sync.Once
+	file_external_gitlab_environments_environments_v1_proto_rawDescData = file_external_gitlab_environments_environments_v1_proto_rawDesc
+)
+
+func file_external_gitlab_environments_environments_v1_proto_rawDescGZIP() []byte {
+	file_external_gitlab_environments_environments_v1_proto_rawDescOnce.Do(func() {
+		file_external_gitlab_environments_environments_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_external_gitlab_environments_environments_v1_proto_rawDescData)
+	})
+	return file_external_gitlab_environments_environments_v1_proto_rawDescData
+}
+
+var file_external_gitlab_environments_environments_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_external_gitlab_environments_environments_v1_proto_goTypes = []interface{}{
+	(*EnvironmentID)(nil),   // 0: gitlab.EnvironmentID
+	(*EnvironmentName)(nil), // 1: gitlab.EnvironmentName
+	(*EnvironmentInfo)(nil), // 2: gitlab.EnvironmentInfo
+}
+var file_external_gitlab_environments_environments_v1_proto_depIdxs = []int32{
+	0, // 0: gitlab.Environments.Get:input_type -> gitlab.EnvironmentID
+	1, // 1: gitlab.Environments.List:input_type -> gitlab.EnvironmentName
+	2, // 2: gitlab.Environments.Get:output_type -> gitlab.EnvironmentInfo
+	2, // 3: gitlab.Environments.List:output_type -> gitlab.EnvironmentInfo
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_external_gitlab_environments_environments_v1_proto_init() }
+func file_external_gitlab_environments_environments_v1_proto_init() {
+	if File_external_gitlab_environments_environments_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_external_gitlab_environments_environments_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_external_gitlab_environments_environments_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_external_gitlab_environments_environments_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_external_gitlab_environments_environments_v1_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_external_gitlab_environments_environments_v1_proto_goTypes,
+		DependencyIndexes: file_external_gitlab_environments_environments_v1_proto_depIdxs,
+		MessageInfos:      file_external_gitlab_environments_environments_v1_proto_msgTypes,
+	}.Build()
+	File_external_gitlab_environments_environments_v1_proto = out.File
+	file_external_gitlab_environments_environments_v1_proto_rawDesc = nil
+	file_external_gitlab_environments_environments_v1_proto_goTypes = nil
+	file_external_gitlab_environments_environments_v1_proto_depIdxs = nil
+}
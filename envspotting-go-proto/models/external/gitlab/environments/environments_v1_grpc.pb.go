@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package environments
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EnvironmentsClient is the client API for Environments service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EnvironmentsClient interface {
+	/// Get Environment by ID
+	Get(ctx context.Context, in *EnvironmentID, opts ...grpc.CallOption) (*EnvironmentInfo, error)
+	/// Stream Environments by name
+	List(ctx context.Context, in *EnvironmentName, opts ...grpc.CallOption) (Environments_ListClient, error)
+}
+
+type environmentsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEnvironmentsClient(cc grpc.ClientConnInterface) EnvironmentsClient {
+	return &environmentsClient{cc}
+}
+
+func (c *environmentsClient) Get(ctx context.Context, in *EnvironmentID, opts ...grpc.CallOption) (*EnvironmentInfo, error) {
+	out := new(EnvironmentInfo)
+	err := c.cc.Invoke(ctx, "/gitlab.Environments/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *environmentsClient) List(ctx context.Context, in *EnvironmentName, opts ...grpc.CallOption) (Environments_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Environments_ServiceDesc.Streams[0], "/gitlab.Environments/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &environmentsListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Environments_ListClient interface {
+	Recv() (*EnvironmentInfo, error)
+	grpc.ClientStream
+}
+
+type environmentsListClient struct {
+	grpc.ClientStream
+}
+
+func (x *environmentsListClient) Recv() (*EnvironmentInfo, error) {
+	m := new(EnvironmentInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EnvironmentsServer is the server API for Environments service.
+// All implementations must embed UnimplementedEnvironmentsServer
+// for forward compatibility
+type EnvironmentsServer interface {
+	/// Get Environment by ID
+	Get(context.Context, *EnvironmentID) (*EnvironmentInfo, error)
+	/// Stream Environments by name
+	List(*EnvironmentName, Environments_ListServer) error
+	mustEmbedUnimplementedEnvironmentsServer()
+}
+
+// UnimplementedEnvironmentsServer must be embedded to have forward compatible implementations.
+type UnimplementedEnvironmentsServer struct {
+}
+
+func (UnimplementedEnvironmentsServer) Get(context.Context, *EnvironmentID) (*EnvironmentInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedEnvironmentsServer) List(*EnvironmentName, Environments_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedEnvironmentsServer) mustEmbedUnimplementedEnvironmentsServer() {}
+
+// UnsafeEnvironmentsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EnvironmentsServer will
+// result in compilation errors.
+type UnsafeEnvironmentsServer interface {
+	mustEmbedUnimplementedEnvironmentsServer()
+}
+
+func RegisterEnvironmentsServer(s grpc.ServiceRegistrar, srv EnvironmentsServer) {
+	s.RegisterService(&Environments_ServiceDesc, srv)
+}
+
+func _Environments_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnvironmentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvironmentsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitlab.Environments/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvironmentsServer).Get(ctx, req.(*EnvironmentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Environments_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EnvironmentName)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnvironmentsServer).List(m, &environmentsListServer{stream})
+}
+
+type Environments_ListServer interface {
+	Send(*EnvironmentInfo) error
+	grpc.ServerStream
+}
+
+type environmentsListServer struct {
+	grpc.ServerStream
+}
+
+func (x *environmentsListServer) Send(m *EnvironmentInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Environments_ServiceDesc is the grpc.ServiceDesc for Environments service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Environments_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gitlab.Environments",
+	HandlerType: (*EnvironmentsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Environments_Get_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _Environments_List_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "external/gitlab/environments/environments_v1.proto",
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package projects
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProjectsClient is the client API for Projects service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectsClient interface {
+	/// Get project by ID
+	Get(ctx context.Context, in *ProjectID, opts ...grpc.CallOption) (*ProjectInfo, error)
+	/// Stream projects by name
+	List(ctx context.Context, in *ProjectName, opts ...grpc.CallOption) (Projects_ListClient, error)
+}
+
+type projectsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectsClient(cc grpc.ClientConnInterface) ProjectsClient {
+	return &projectsClient{cc}
+}
+
+func (c *projectsClient) Get(ctx context.Context, in *ProjectID, opts ...grpc.CallOption) (*ProjectInfo, error) {
+	out := new(ProjectInfo)
+	err := c.cc.Invoke(ctx, "/gitlab.Projects/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) List(ctx context.Context, in *ProjectName, opts ...grpc.CallOption) (Projects_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Projects_ServiceDesc.Streams[0], "/gitlab.Projects/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &projectsListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Projects_ListClient interface {
+	Recv() (*ProjectInfo, error)
+	grpc.ClientStream
+}
+
+type projectsListClient struct {
+	grpc.ClientStream
+}
+
+func (x *projectsListClient) Recv() (*ProjectInfo, error) {
+	m := new(ProjectInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ProjectsServer is the server API for Projects service.
+// All implementations must embed UnimplementedProjectsServer
+// for forward compatibility
+type ProjectsServer interface {
+	/// Get project by ID
+	Get(context.Context, *ProjectID) (*ProjectInfo, error)
+	/// Stream projects by name
+	List(*ProjectName, Projects_ListServer) error
+	mustEmbedUnimplementedProjectsServer()
+}
+
+// UnimplementedProjectsServer must be embedded to have forward compatible implementations.
+type UnimplementedProjectsServer struct {
+}
+
+func (UnimplementedProjectsServer) Get(context.Context, *ProjectID) (*ProjectInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedProjectsServer) List(*ProjectName, Projects_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedProjectsServer) mustEmbedUnimplementedProjectsServer() {}
+
+// UnsafeProjectsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectsServer will
+// result in compilation errors.
+type UnsafeProjectsServer interface {
+	mustEmbedUnimplementedProjectsServer()
+}
+
+func RegisterProjectsServer(s grpc.ServiceRegistrar, srv ProjectsServer) {
+	s.RegisterService(&Projects_ServiceDesc, srv)
+}
+
+func _Projects_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitlab.Projects/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).Get(ctx, req.(*ProjectID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProjectName)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProjectsServer).List(m, &projectsListServer{stream})
+}
+
+type Projects_ListServer interface {
+	Send(*ProjectInfo) error
+	grpc.ServerStream
+}
+
+type projectsListServer struct {
+	grpc.ServerStream
+}
+
+func (x *projectsListServer) Send(m *ProjectInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Projects_ServiceDesc is the grpc.ServiceDesc for Projects service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Projects_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gitlab.Projects",
+	HandlerType: (*ProjectsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Projects_Get_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _Projects_List_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "external/gitlab/projects/projects_v1.proto",
+}
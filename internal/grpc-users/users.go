@@ -34,7 +34,7 @@ func Connect() {
 	})
 	conn, err := grpc.Dial(getHost(), grpc.WithInsecure(), grpc.WithBlock(), grpcopt)
 	if err != nil {
-		log.Error("did not connect: %v", err)
+		log.Errorf("did not connect: %v", err)
 	}
 	AccountClient = accounts.NewAccountsClient(conn)
 	RightsClient = rights.NewRightsClient(conn)
@@ -1,15 +1,21 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"fmt"
 	"net"
+	"net/http"
 
 	applications "github.com/badhouseplants/envspotting-apps/service/applications"
 	contours "github.com/badhouseplants/envspotting-apps/service/contours"
+	webhooks "github.com/badhouseplants/envspotting-apps/service/webhooks"
 
 	grpcusers "github.com/badhouseplants/envspotting-apps/internal/grpc-users"
 	"github.com/badhouseplants/envspotting-apps/migrations"
 	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-apps/workers/poller"
+	"github.com/badhouseplants/envspotting-apps/workers/renames"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -27,6 +33,10 @@ func init() {
 	// app variables
 	viper.SetDefault("envspotting_apps_host", "0.0.0.0")
 	viper.SetDefault("envspotting_apps_port", "9090")
+	viper.SetDefault("envspotting_apps_webhooks_port", "8080")
+	// counters are only served on the loopback interface unless configured otherwise
+	viper.SetDefault("envspotting_apps_debug_host", "127.0.0.1")
+	viper.SetDefault("envspotting_apps_debug_port", "8081")
 	viper.SetDefault("envspotting_users_host", "0.0.0.0")
 	viper.SetDefault("envspotting_users_port", "9090")
 	viper.SetDefault("database_username", "docker_user")
@@ -34,6 +44,24 @@ func init() {
 	viper.SetDefault("database_name", "applications")
 	viper.SetDefault("database_host", "localhost")
 	viper.SetDefault("database_port", "5432")
+	viper.SetDefault("gitlab_webhook_token", "")
+	viper.SetDefault("gitlab_cache_size", 1000)
+	viper.SetDefault("gitlab_cache_max_bytes", 64<<20)
+	viper.SetDefault("github_token", "")
+	viper.SetDefault("secrets_encryption_key", "")
+	viper.SetDefault("poller_enabled", true)
+	viper.SetDefault("poller_interval", "1m")
+	viper.SetDefault("poller_concurrency", 8)
+	viper.SetDefault("poller_rate_limit", 5)
+	viper.SetDefault("poller_backoff_base", "30s")
+	viper.SetDefault("poller_backoff_max", "30m")
+	viper.SetDefault("status_stale_after", "10m")
+	viper.SetDefault("renames_enabled", true)
+	viper.SetDefault("renames_interval", "1h")
+	viper.SetDefault("redis_host", "localhost:6379")
+	// caching in redis is off until ttls are set
+	viper.SetDefault("cache_applications_ttl", "0s")
+	viper.SetDefault("cache_contours_ttl", "0s")
 	viper.AutomaticEnv() // read in environment variables that match)
 }
 
@@ -67,6 +95,17 @@ func main() {
 
 	registerServices(grpcServer)
 
+	// seting up webhooks server
+	go serveWebhooks()
+	go serveDebug()
+	// polling deployment states
+	if err := poller.Start(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if err := renames.Start(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+
 	log.Infof("starting to serve on %s", getHost())
 	grpcServer.Serve(listener)
 }
@@ -76,6 +115,36 @@ func getHost() string {
 	return host
 }
 
+func getWebhooksHost() string {
+	return fmt.Sprintf("%s:%s", viper.GetString("envspotting_apps_host"), viper.GetString("envspotting_apps_webhooks_port"))
+}
+
+func serveWebhooks() {
+	log := logger.GetServerLogger()
+	log.Infof("starting to serve webhooks on %s", getWebhooksHost())
+	mux := http.NewServeMux()
+	mux.Handle("/webhooks/", webhooks.Handler())
+	if err := http.ListenAndServe(getWebhooksHost(), mux); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func getDebugHost() string {
+	return fmt.Sprintf("%s:%s", viper.GetString("envspotting_apps_debug_host"), viper.GetString("envspotting_apps_debug_port"))
+}
+
+// serveDebug serves counters of requests to gitlab and of cache hits apart from
+// the webhooks listener, which is exposed to gitlab without authentication
+func serveDebug() {
+	log := logger.GetServerLogger()
+	log.Infof("starting to serve debug vars on %s", getDebugHost())
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	if err := http.ListenAndServe(getDebugHost(), mux); err != nil {
+		log.Fatal(err)
+	}
+}
+
 func setupGrpcUnaryOpts() grpc.ServerOption {
 	return grpc_middleware.WithUnaryServerChain(
 		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS service_deployment_state;
\ No newline at end of file
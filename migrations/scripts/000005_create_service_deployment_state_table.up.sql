@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS service_deployment_state (
+  contour_id TEXT REFERENCES contours(id) ON DELETE CASCADE,
+  service_id TEXT,
+  project BIGINT,
+  environment BIGINT,
+  ref TEXT,
+  sha TEXT,
+  status TEXT,
+  deployer TEXT,
+  commit_title TEXT,
+  deployable_url TEXT,
+  pipeline_id BIGINT,
+  pipeline_status TEXT,
+  updated_at TIMESTAMPTZ,
+  PRIMARY KEY (contour_id, service_id)
+);
@@ -0,0 +1 @@
+ALTER TABLE applications DROP COLUMN IF EXISTS gitlab_url, DROP COLUMN IF EXISTS gitlab_token, DROP COLUMN IF EXISTS gitlab_ca_bundle;
\ No newline at end of file
@@ -0,0 +1,11 @@
+DO $$ 
+  BEGIN
+    BEGIN
+      ALTER TABLE applications ADD COLUMN gitlab_url TEXT;
+      ALTER TABLE applications ADD COLUMN gitlab_token BYTEA;
+      ALTER TABLE applications ADD COLUMN gitlab_ca_bundle TEXT;
+    EXCEPTION
+      WHEN duplicate_column THEN RAISE NOTICE 'column already exists in applications.';
+    END;
+  END;
+$$;
@@ -0,0 +1 @@
+ALTER TABLE service_deployment_state DROP COLUMN IF EXISTS finished_at, DROP COLUMN IF EXISTS external_url;
\ No newline at end of file
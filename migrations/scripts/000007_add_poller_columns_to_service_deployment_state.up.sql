@@ -0,0 +1,10 @@
+DO $$ 
+  BEGIN
+    BEGIN
+      ALTER TABLE service_deployment_state ADD COLUMN finished_at TIMESTAMPTZ;
+      ALTER TABLE service_deployment_state ADD COLUMN external_url TEXT;
+    EXCEPTION
+      WHEN duplicate_column THEN RAISE NOTICE 'column already exists in service_deployment_state.';
+    END;
+  END;
+$$;
@@ -0,0 +1,2 @@
+ALTER TABLE contours DROP COLUMN IF EXISTS service_providers;
+ALTER TABLE applications DROP COLUMN IF EXISTS github_url, DROP COLUMN IF EXISTS github_token;
\ No newline at end of file
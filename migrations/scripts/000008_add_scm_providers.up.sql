@@ -0,0 +1,11 @@
+DO $$ 
+  BEGIN
+    BEGIN
+      ALTER TABLE contours ADD COLUMN service_providers JSONB;
+      ALTER TABLE applications ADD COLUMN github_url TEXT;
+      ALTER TABLE applications ADD COLUMN github_token BYTEA;
+    EXCEPTION
+      WHEN duplicate_column THEN RAISE NOTICE 'column already exists.';
+    END;
+  END;
+$$;
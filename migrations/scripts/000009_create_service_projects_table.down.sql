@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS service_project_renames;
+DROP TABLE IF EXISTS service_projects;
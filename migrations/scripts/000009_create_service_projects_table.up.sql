@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS service_projects (
+  contour_id TEXT REFERENCES contours(id) ON DELETE CASCADE,
+  service_id TEXT,
+  project BIGINT,
+  path TEXT,
+  updated_at TIMESTAMPTZ,
+  PRIMARY KEY (contour_id, service_id)
+);
+CREATE TABLE IF NOT EXISTS service_project_renames (
+  id BIGSERIAL PRIMARY KEY,
+  contour_id TEXT,
+  service_id TEXT,
+  project BIGINT,
+  old_path TEXT,
+  new_path TEXT,
+  renamed_at TIMESTAMPTZ
+);
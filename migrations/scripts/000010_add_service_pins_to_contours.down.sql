@@ -0,0 +1 @@
+ALTER TABLE contours DROP COLUMN IF EXISTS service_pins;
@@ -0,0 +1,9 @@
+DO $$ 
+  BEGIN
+    BEGIN
+      ALTER TABLE contours ADD COLUMN service_pins JSONB;
+    EXCEPTION
+      WHEN duplicate_column THEN RAISE NOTICE 'column already exists.';
+    END;
+  END;
+$$;
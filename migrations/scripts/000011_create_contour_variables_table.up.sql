@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS contour_variables (
+  contour_id TEXT REFERENCES contours(id) ON DELETE CASCADE,
+  key TEXT,
+  value BYTEA,
+  masked BOOLEAN,
+  PRIMARY KEY (contour_id, key)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS contour_merge_requests;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS contour_merge_requests (
+  contour_id TEXT REFERENCES contours(id) ON DELETE CASCADE,
+  project BIGINT,
+  iid BIGINT,
+  title TEXT,
+  web_url TEXT,
+  note_id BIGINT,
+  created_at TIMESTAMPTZ,
+  PRIMARY KEY (contour_id, project, iid)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ephemeral_contours;
+DROP TABLE IF EXISTS ephemeral_contour_rules;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS ephemeral_contour_rules (
+  application_id TEXT PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  projects BIGINT[],
+  environment_pattern TEXT
+);
+CREATE TABLE IF NOT EXISTS ephemeral_contours (
+  application_id TEXT REFERENCES applications(id) ON DELETE CASCADE,
+  project BIGINT,
+  iid BIGINT,
+  contour_id TEXT REFERENCES contours(id) ON DELETE CASCADE,
+  PRIMARY KEY (application_id, project, iid)
+);
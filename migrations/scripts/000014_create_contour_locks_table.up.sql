@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS contour_locks (
+  contour_id TEXT REFERENCES contours(id) ON DELETE RESTRICT,
+  service_id TEXT,
+  project BIGINT,
+  environment TEXT,
+  previous_protection JSONB,
+  locked_at TIMESTAMPTZ,
+  PRIMARY KEY (contour_id, service_id)
+);
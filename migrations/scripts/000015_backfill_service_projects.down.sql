@@ -0,0 +1 @@
+DELETE FROM service_projects WHERE path IS NULL;
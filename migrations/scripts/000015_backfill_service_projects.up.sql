@@ -0,0 +1,5 @@
+INSERT INTO service_projects (contour_id, service_id, project, path, updated_at)
+SELECT c.id, obj.val->>'id', (obj.val->>'project')::BIGINT, NULL, now()
+FROM contours c
+JOIN LATERAL jsonb_array_elements(c.services) obj(val) ON obj.val->>'project' IS NOT NULL
+ON CONFLICT (contour_id, service_id) DO NOTHING;
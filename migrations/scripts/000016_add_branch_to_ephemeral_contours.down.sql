@@ -0,0 +1 @@
+ALTER TABLE ephemeral_contours DROP COLUMN IF EXISTS branch;
@@ -0,0 +1,9 @@
+DO $$ 
+  BEGIN
+    BEGIN
+      ALTER TABLE ephemeral_contours ADD COLUMN branch TEXT;
+    EXCEPTION
+      WHEN duplicate_column THEN RAISE NOTICE 'column already exists.';
+    END;
+  END;
+$$;
@@ -0,0 +1 @@
+ALTER TABLE applications DROP COLUMN IF EXISTS gitlab_lock_group_id;
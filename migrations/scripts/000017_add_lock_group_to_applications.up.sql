@@ -0,0 +1,9 @@
+DO $$ 
+  BEGIN
+    BEGIN
+      ALTER TABLE applications ADD COLUMN gitlab_lock_group_id BIGINT;
+    EXCEPTION
+      WHEN duplicate_column THEN RAISE NOTICE 'column already exists in applications.';
+    END;
+  END;
+$$;
@@ -0,0 +1 @@
+ALTER TABLE service_deployment_state DROP COLUMN IF EXISTS deployment_id;
@@ -0,0 +1,9 @@
+DO $$ 
+  BEGIN
+    BEGIN
+      ALTER TABLE service_deployment_state ADD COLUMN deployment_id BIGINT NOT NULL DEFAULT 0;
+    EXCEPTION
+      WHEN duplicate_column THEN RAISE NOTICE 'column already exists in service_deployment_state.';
+    END;
+  END;
+$$;
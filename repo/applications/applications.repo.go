@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/badhouseplants/envspotting-apps/repo/cache"
 	"github.com/badhouseplants/envspotting-apps/tools/logger"
 	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
 	"github.com/badhouseplants/envspotting-go-proto/models/users/accounts"
@@ -26,20 +27,41 @@ type ApplicationStore interface {
 	Update(context.Context, *applications.AppWithoutContours) error
 	ListAdded(context.Context, applications.Applications_ListServer, *accounts.AccountsApps) error
 	ListAvailable(context.Context, applications.Applications_ListServer, []string) error
+	GetGitlabConnection(context.Context, *applications.AppId) (*GitlabConnection, error)
+	SetGitlabConnection(context.Context, *applications.AppId, *GitlabConnection) error
+	GetGithubConnection(context.Context, *applications.AppId) (*GithubConnection, error)
+	SetGithubConnection(context.Context, *applications.AppId, *GithubConnection) error
+}
+
+// GitlabConnection of an application, the token is stored encrypted
+type GitlabConnection struct {
+	URL            string
+	EncryptedToken []byte
+	CABundle       string
+	// LockGroupID can deploy to environments of locked contours, 0 if not set
+	LockGroupID int64
+}
+
+// GithubConnection of an application, the token is stored encrypted
+type GithubConnection struct {
+	URL            string
+	EncryptedToken []byte
 }
 
 // ApplicationRepo implements ApplicationRepo
 type ApplicationRepo struct {
-	Pool      *pgxpool.Conn
+	// Pool acquires a connection per call, calls answered from the cache don't take one
+	Pool      func(context.Context) *pgxpool.Conn
 	CreatedAt time.Time
 }
 
 // Create application (add to database)
 func (store ApplicationRepo) Create(ctx context.Context, app *applications.AppWithoutContours) (err error) {
-	defer store.Pool.Release()
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = "INSERT INTO applications (id, name, description) VALUES ($1, $2, $3);"
 	var log = logger.GetGrpcLogger(ctx)
-	_, err = store.Pool.Exec(ctx, sql, app.GetId(), app.GetName(), app.GetDescription())
+	_, err = db.Exec(ctx, sql, app.GetId(), app.GetName(), app.GetDescription())
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -57,14 +79,20 @@ func (store ApplicationRepo) Create(ctx context.Context, app *applications.AppWi
 
 // Get application (from database)
 func (store ApplicationRepo) Get(ctx context.Context, appIn *applications.AppId) (*applications.AppFullInfo, error) {
-	defer store.Pool.Release()
 	const sql = "SELECT id, name, description, contours FROM applications WHERE id = $1"
 	var (
 		err    error
 		appOut = &applications.AppFullInfo{}
 		log    = logger.GetGrpcLogger(ctx)
+		cached = cache.Applications()
 	)
-	err = store.Pool.QueryRow(ctx, sql, appIn.GetId()).Scan(&appOut.Id, &appOut.Name, &appOut.Description, &appOut.Contours)
+	if cached.Get(ctx, appIn.GetId(), appOut) {
+		return appOut, nil
+	}
+	// The connection is only taken on a cache miss
+	db := store.Pool(ctx)
+	defer db.Release()
+	err = db.QueryRow(ctx, sql, appIn.GetId()).Scan(&appOut.Id, &appOut.Name, &appOut.Description, &appOut.Contours)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", appIn.Id))
@@ -73,15 +101,17 @@ func (store ApplicationRepo) Get(ctx context.Context, appIn *applications.AppId)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
+	cached.Set(ctx, appIn.GetId(), appOut)
 	return appOut, nil
 }
 
 // Update applications (database update)
 func (store ApplicationRepo) Update(ctx context.Context, app *applications.AppWithoutContours) (err error) {
-	defer store.Pool.Release()
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = "UPDATE applications SET name=$2, description=$3 WHERE id=$1 RETURNING *"
 	var log = logger.GetGrpcLogger(ctx)
-	tag, err := store.Pool.Exec(ctx, sql, app.GetId(), app.GetName(), app.GetDescription())
+	tag, err := db.Exec(ctx, sql, app.GetId(), app.GetName(), app.GetDescription())
 	if tag.RowsAffected() == 0 {
 		return status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", app.Id))
 	}
@@ -97,12 +127,14 @@ func (store ApplicationRepo) Update(ctx context.Context, app *applications.AppWi
 			}
 		}
 	}
+	cache.Applications().Invalidate(ctx, app.GetId())
 	return nil
 }
 
 // List applications (streaming from database)
 func (store ApplicationRepo) ListAvailable(ctx context.Context, stream applications.Applications_ListServer, apps []string) error {
-	defer store.Pool.Release()
+	db := store.Pool(ctx)
+	defer db.Release()
 	// TODO: Add pagination @allanger
 	const sql = "SELECT id, name, description FROM applications WHERE id=ANY($1);"
 	var (
@@ -110,7 +142,7 @@ func (store ApplicationRepo) ListAvailable(ctx context.Context, stream applicati
 		app = &applications.AppWithoutContours{}
 	)
 	// Get applications
-	rows, err := store.Pool.Query(ctx, sql, apps)
+	rows, err := db.Query(ctx, sql, apps)
 	if err != nil {
 		log.Error(err)
 		return status.Error(codes.Internal, err.Error())
@@ -134,7 +166,8 @@ func (store ApplicationRepo) ListAvailable(ctx context.Context, stream applicati
 
 // List applications (streaming from database)
 func (store ApplicationRepo) ListAdded(ctx context.Context, stream applications.Applications_ListServer, apps *accounts.AccountsApps) error {
-	defer store.Pool.Release()
+	db := store.Pool(ctx)
+	defer db.Release()
 	// TODO: Add pagination @allanger
 	const sql = "SELECT id, name, description FROM applications WHERE id=ANY($1)"
 	var (
@@ -142,7 +175,7 @@ func (store ApplicationRepo) ListAdded(ctx context.Context, stream applications.
 		app = &applications.AppWithoutContours{}
 	)
 	// Get applications
-	rows, err := store.Pool.Query(ctx, sql, apps.Apps)
+	rows, err := db.Query(ctx, sql, apps.Apps)
 	if err != nil {
 		log.Error(err)
 		return status.Error(codes.Internal, err.Error())
@@ -166,15 +199,15 @@ func (store ApplicationRepo) ListAdded(ctx context.Context, stream applications.
 
 // Get application (from database)
 func (store ApplicationRepo) Delete(ctx context.Context, appIn *applications.AppId) (err error) {
-	defer store.Pool.Release()
-	const sql = "DELETE FROM applications WHERE id = $1"
+	db := store.Pool(ctx)
+	defer db.Release()
+	// Contours are deleted with the application, so they are returned to be dropped from the cache
+	const sql = "DELETE FROM applications WHERE id = $1 RETURNING COALESCE(contours, '{}')"
 	var (
-		log = logger.GetGrpcLogger(ctx)
+		log        = logger.GetGrpcLogger(ctx)
+		contourIDs []string
 	)
-	tag, err := store.Pool.Exec(ctx, sql, appIn.Id)
-	if tag.RowsAffected() == 0 {
-		return status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", appIn.Id))
-	}
+	err = db.QueryRow(ctx, sql, appIn.Id).Scan(&contourIDs)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", appIn.Id))
@@ -183,5 +216,81 @@ func (store ApplicationRepo) Delete(ctx context.Context, appIn *applications.App
 			return status.Error(codes.Internal, err.Error())
 		}
 	}
+	cache.Applications().Invalidate(ctx, appIn.GetId())
+	cache.Contours().Invalidate(ctx, contourIDs...)
+	return nil
+}
+
+// GetGitlabConnection of an application (from database)
+func (store ApplicationRepo) GetGitlabConnection(ctx context.Context, appIn *applications.AppId) (*GitlabConnection, error) {
+	db := store.Pool(ctx)
+	defer db.Release()
+	const sql = "SELECT COALESCE(gitlab_url, ''), gitlab_token, COALESCE(gitlab_ca_bundle, ''), COALESCE(gitlab_lock_group_id, 0) FROM applications WHERE id = $1"
+	var (
+		conn = &GitlabConnection{}
+		log  = logger.GetGrpcLogger(ctx)
+	)
+	err := db.QueryRow(ctx, sql, appIn.GetId()).Scan(&conn.URL, &conn.EncryptedToken, &conn.CABundle, &conn.LockGroupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", appIn.GetId()))
+		}
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return conn, nil
+}
+
+// SetGitlabConnection of an application (database update)
+func (store ApplicationRepo) SetGitlabConnection(ctx context.Context, appIn *applications.AppId, conn *GitlabConnection) error {
+	db := store.Pool(ctx)
+	defer db.Release()
+	const sql = "UPDATE applications SET gitlab_url=$2, gitlab_token=$3, gitlab_ca_bundle=$4, gitlab_lock_group_id=NULLIF($5, 0) WHERE id=$1"
+	var log = logger.GetGrpcLogger(ctx)
+	tag, err := db.Exec(ctx, sql, appIn.GetId(), conn.URL, conn.EncryptedToken, conn.CABundle, conn.LockGroupID)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", appIn.GetId()))
+	}
+	return nil
+}
+
+// GetGithubConnection of an application (from database)
+func (store ApplicationRepo) GetGithubConnection(ctx context.Context, appIn *applications.AppId) (*GithubConnection, error) {
+	db := store.Pool(ctx)
+	defer db.Release()
+	const sql = "SELECT COALESCE(github_url, ''), github_token FROM applications WHERE id = $1"
+	var (
+		conn = &GithubConnection{}
+		log  = logger.GetGrpcLogger(ctx)
+	)
+	err := db.QueryRow(ctx, sql, appIn.GetId()).Scan(&conn.URL, &conn.EncryptedToken)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", appIn.GetId()))
+		}
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return conn, nil
+}
+
+// SetGithubConnection of an application (database update)
+func (store ApplicationRepo) SetGithubConnection(ctx context.Context, appIn *applications.AppId, conn *GithubConnection) error {
+	db := store.Pool(ctx)
+	defer db.Release()
+	const sql = "UPDATE applications SET github_url=$2, github_token=$3 WHERE id=$1"
+	var log = logger.GetGrpcLogger(ctx)
+	tag, err := db.Exec(ctx, sql, appIn.GetId(), conn.URL, conn.EncryptedToken)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, fmt.Sprintf("application with this id can't be found: %s", appIn.GetId()))
+	}
 	return nil
 }
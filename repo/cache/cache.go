@@ -0,0 +1,16 @@
+package cache
+
+import (
+	"github.com/badhouseplants/envspotting-apps/third_party/redis"
+	"github.com/spf13/viper"
+)
+
+// Applications returned by ApplicationRepo.Get, values live for cache_applications_ttl
+func Applications() *redis.Cache {
+	return redis.NewCache("applications", viper.GetDuration("cache_applications_ttl"))
+}
+
+// Contours returned by ContourRepo.Get, values live for cache_contours_ttl
+func Contours() *redis.Cache {
+	return redis.NewCache("contours", viper.GetDuration("cache_contours_ttl"))
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/badhouseplants/envspotting-apps/repo/cache"
+	projectsRepo "github.com/badhouseplants/envspotting-apps/repo/projects"
 	"github.com/badhouseplants/envspotting-apps/tools/logger"
 	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,31 +25,37 @@ type ContourStore interface {
 	Update(context.Context, *contours.ContourInfoWithoutServices) error
 	List(context.Context, contours.Contours_ListServer, *contours.ContoursListOption) error
 	Delete(context.Context, *contours.ContourIdAndName) error
-	AddServices(context.Context, *contours.RepeatedServiceWithId) error
+	AddServices(context.Context, *contours.RepeatedServiceWithId, map[string]string, []*projectsRepo.ServiceProject) error
 	RemoveService(context.Context, *contours.ServiceIdAndContourId) error
 	GetAppIDByContourID(context.Context, string) (string, error) 
+	GetServiceProviders(context.Context, string) (map[string]string, error)
+	GetServicePins(context.Context, string) (map[string]string, error)
+	SetServicePins(context.Context, string, map[string]*string) error
 }
 
 // ContourRepo implements ContoueRepo
 type ContourRepo struct {
-	Pool      *pgxpool.Conn
+	// Pool is called once per method, after the cache is checked
+	Pool      func(context.Context) *pgxpool.Conn
 	CreatedAt time.Time
 }
 
 // Create a contour (add to db)
 func (store ContourRepo) Create(ctx context.Context, contour *contours.ContourInfoWithoutServices) error {
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sqlAddContour = "INSERT INTO contours (id, application_id, name, description) VALUES ($1, $2, $3, $4)"
 	const sqlPairContourAndApp = "UPDATE applications SET contours = array_append(contours, $1) WHERE id=$2;"
 	var log = logger.GetGrpcLogger(ctx)
 	// Begin transaction
-	tx, err := store.Pool.Begin(ctx)
+	tx, err := db.Begin(ctx)
 	if err != nil {
 		log.Error(err)
 		return status.Error(codes.Internal, err.Error())
 	}
 	defer tx.Rollback(ctx)
 	// Add contour
-	_, err = store.Pool.Exec(ctx, sqlAddContour, contour.GetId(), contour.GetAppId(), contour.GetName(), contour.GetDescription())
+	_, err = db.Exec(ctx, sqlAddContour, contour.GetId(), contour.GetAppId(), contour.GetName(), contour.GetDescription())
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -61,11 +69,13 @@ func (store ContourRepo) Create(ctx context.Context, contour *contours.ContourIn
 		}
 	}
 	// Pair contour and app
-	_, err = store.Pool.Exec(ctx, sqlPairContourAndApp, contour.Id, contour.AppId)
+	_, err = db.Exec(ctx, sqlPairContourAndApp, contour.Id, contour.AppId)
 	if err != nil {
 		log.Error(err)
 		return status.Error(codes.Internal, err.Error())
 	}
+	// Contours of the app have changed
+	cache.Applications().Invalidate(ctx, contour.GetAppId())
 	return nil
 }
 
@@ -76,8 +86,15 @@ func (store ContourRepo) Get(ctx context.Context, contourIn *contours.ContourId)
 		err        error
 		contourOut = &contours.ContourInfo{}
 		log        = logger.GetGrpcLogger(ctx)
+		cached     = cache.Contours()
 	)
-	err = store.Pool.QueryRow(ctx, sql, contourIn.GetId()).Scan(&contourOut.Id, &contourOut.Name, &contourOut.Description, &contourOut.Services)
+	if cached.Get(ctx, contourIn.GetId(), contourOut) {
+		return contourOut, nil
+	}
+	// The connection is only taken on a cache miss
+	db := store.Pool(ctx)
+	defer db.Release()
+	err = db.QueryRow(ctx, sql, contourIn.GetId()).Scan(&contourOut.Id, &contourOut.Name, &contourOut.Description, &contourOut.Services)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, status.Error(codes.NotFound, fmt.Sprintf("contour with this id can't be found: %s", contourIn.Id))
@@ -86,13 +103,16 @@ func (store ContourRepo) Get(ctx context.Context, contourIn *contours.ContourId)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
+	cached.Set(ctx, contourIn.GetId(), contourOut)
 	return contourOut, nil
 }
 
 func (store ContourRepo) Update(ctx context.Context, contour *contours.ContourInfoWithoutServices) error {
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = "UPDATE contours SET name=$2, description=$3 WHERE id=$1 RETURNING *"
 	var log = logger.GetGrpcLogger(ctx)
-	tag, err := store.Pool.Exec(ctx, sql, contour.GetId(), contour.GetName(), contour.GetDescription())
+	tag, err := db.Exec(ctx, sql, contour.GetId(), contour.GetName(), contour.GetDescription())
 	if tag.RowsAffected() == 0 {
 		return status.Error(codes.NotFound, fmt.Sprintf("contour with this id can't be found: %s", contour.Id))
 	}
@@ -106,17 +126,20 @@ func (store ContourRepo) Update(ctx context.Context, contour *contours.ContourIn
 			}
 		}
 	}
+	cache.Contours().Invalidate(ctx, contour.GetId())
 	return nil
 }
 
 func (store ContourRepo) List(ctx context.Context, stream contours.Contours_ListServer, options *contours.ContoursListOption) error {
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = "SELECT id, name, description, services FROM contours WHERE contours.id=ANY(ARRAY(SELECT contours FROM applications WHERE applications.id=$1))"
 	var (
 		log     = logger.GetGrpcLogger(ctx)
 		contour = &contours.ContourInfo{}
 	)
 	// Get contours
-	rows, err := store.Pool.Query(ctx, sql, options.AppId)
+	rows, err := db.Query(ctx, sql, options.AppId)
 	if err != nil {
 		log.Error(err)
 		return status.Error(codes.Internal, err.Error())
@@ -140,6 +163,8 @@ func (store ContourRepo) List(ctx context.Context, stream contours.Contours_List
 
 // Delete a contour
 func (store ContourRepo) Delete(ctx context.Context, contour *contours.ContourIdAndName) (err error) {
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = `DELETE FROM contours 
 	WHERE id=(
 		SELECT id FROM contours WHERE contours.id=ANY(ARRAY(
@@ -149,11 +174,13 @@ func (store ContourRepo) Delete(ctx context.Context, contour *contours.ContourId
 	  AND contours.id=$1);
 `
 	var log = logger.GetGrpcLogger(ctx)
-	tag, err := store.Pool.Exec(ctx, sql, contour.Id, contour.AppId)
-	if tag.RowsAffected() == 0 {
-		return status.Error(codes.NotFound, fmt.Sprintf("contour with this id (%s) doesn't belong to the application %s", contour.Id, contour.AppId))
-	}
+	tag, err := db.Exec(ctx, sql, contour.Id, contour.AppId)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			// contour_locks restricts deleting locked contours
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("contour %s is locked, unlock it first", contour.Id))
+		}
 		if err == pgx.ErrNoRows {
 			return status.Error(codes.NotFound, fmt.Sprintf("contour with this id can't be found: %s", contour.Id))
 		} else {
@@ -161,46 +188,63 @@ func (store ContourRepo) Delete(ctx context.Context, contour *contours.ContourId
 			return status.Error(codes.Internal, err.Error())
 		}
 	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, fmt.Sprintf("contour with this id (%s) doesn't belong to the application %s", contour.Id, contour.AppId))
+	}
+	cache.Contours().Invalidate(ctx, contour.GetId())
 	return nil
 }
 
-func (store ContourRepo) AddServices(ctx context.Context, contour *contours.RepeatedServiceWithId) (err error) {
+// AddServices to a contour together with their provider types and project paths in one transaction
+func (store ContourRepo) AddServices(ctx context.Context, contour *contours.RepeatedServiceWithId, providers map[string]string, projects []*projectsRepo.ServiceProject) (err error) {
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = `UPDATE contours SET services = (
     CASE
         WHEN services IS NULL THEN '[]'::JSONB
         ELSE services
     END
-) || $2::JSONB WHERE id = $1;`
+) || $2::JSONB, service_providers = COALESCE(service_providers, '{}'::JSONB) || $3::JSONB WHERE id = $1;`
 
 	var log = logger.GetGrpcLogger(ctx)
-	tag, err := store.Pool.Exec(ctx, sql, contour.GetContourId(), contour.GetServices())
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer tx.Rollback(ctx)
+	tag, err := tx.Exec(ctx, sql, contour.GetContourId(), contour.GetServices(), providers)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
 	if tag.RowsAffected() == 0 {
 		return status.Error(codes.NotFound, fmt.Sprintf("contour with this id can't be found: %s", contour.GetContourId()))
 	}
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			default:
-				log.Error(err)
-				return status.Error(codes.Internal, err.Error())
-			}
-		}
+	if err := projectsRepo.SetInTx(ctx, tx, projects, store.CreatedAt); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
 	}
+	cache.Contours().Invalidate(ctx, contour.GetContourId())
 	return nil
 }
 
 func (store ContourRepo) RemoveService(ctx context.Context, in *contours.ServiceIdAndContourId) error {
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = `
 UPDATE contours c SET services = array_to_json(
 ARRAY(SELECT obj.val AS count
 	FROM   contours c
 	JOIN   LATERAL jsonb_array_elements(c.services) obj(val) ON obj.val->>'id' != $2
 	WHERE  c.id = $1 )
-) WHERE c.id = $1;
+), service_providers = service_providers - $2::TEXT, service_pins = service_pins - $2::TEXT WHERE c.id = $1;
 `
 	var log = logger.GetGrpcLogger(ctx)
-	tag, err := store.Pool.Exec(ctx, sql, in.GetContourId(), in.GetServiceId())
+	tag, err := db.Exec(ctx, sql, in.GetContourId(), in.GetServiceId())
 	if tag.RowsAffected() == 0 {
 		return status.Error(codes.NotFound, "no rows affected")
 	}
@@ -214,14 +258,17 @@ ARRAY(SELECT obj.val AS count
 			}
 		}
 	}
+	cache.Contours().Invalidate(ctx, in.GetContourId())
 	return nil
 }
 
 func (store ContourRepo) GetAppIDByContourID(ctx context.Context, contourID string) (string, error) {
+	db := store.Pool(ctx)
+	defer db.Release()
 	const sql = "SELECT application_id FROM contours WHERE id = $1"
 	var appID string
 	var log = logger.GetGrpcLogger(ctx)
-	err := store.Pool.QueryRow(ctx, sql, contourID).Scan(&appID)
+	err := db.QueryRow(ctx, sql, contourID).Scan(&appID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -233,4 +280,61 @@ func (store ContourRepo) GetAppIDByContourID(ctx context.Context, contourID stri
 		}
 	}
 	return appID, nil
-}
\ No newline at end of file
+}
+
+// GetServiceProviders returns provider types of contour services by service id
+func (store ContourRepo) GetServiceProviders(ctx context.Context, contourID string) (map[string]string, error) {
+	db := store.Pool(ctx)
+	defer db.Release()
+	const sql = "SELECT COALESCE(service_providers, '{}'::JSONB) FROM contours WHERE id = $1"
+	var (
+		providers = map[string]string{}
+		log       = logger.GetGrpcLogger(ctx)
+	)
+	err := db.QueryRow(ctx, sql, contourID).Scan(&providers)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("contour with this id can't be found: %s", contourID))
+		}
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return providers, nil
+}
+
+// GetServicePins returns refs contour services are pinned to by service id
+func (store ContourRepo) GetServicePins(ctx context.Context, contourID string) (map[string]string, error) {
+	db := store.Pool(ctx)
+	defer db.Release()
+	const sql = "SELECT COALESCE(service_pins, '{}'::JSONB) FROM contours WHERE id = $1"
+	var (
+		pins = map[string]string{}
+		log  = logger.GetGrpcLogger(ctx)
+	)
+	err := db.QueryRow(ctx, sql, contourID).Scan(&pins)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("contour with this id can't be found: %s", contourID))
+		}
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return pins, nil
+}
+
+// SetServicePins merges pins of services into the contour, nil pins are removed
+func (store ContourRepo) SetServicePins(ctx context.Context, contourID string, pins map[string]*string) error {
+	db := store.Pool(ctx)
+	defer db.Release()
+	const sql = "UPDATE contours SET service_pins = jsonb_strip_nulls(COALESCE(service_pins, '{}'::JSONB) || $2::JSONB) WHERE id = $1"
+	var log = logger.GetGrpcLogger(ctx)
+	tag, err := db.Exec(ctx, sql, contourID, pins)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, fmt.Sprintf("contour with this id can't be found: %s", contourID))
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeploymentState is the latest known deployment of a contour service
+type DeploymentState struct {
+	ContourID   string
+	ServiceID   string
+	Project     int64
+	Environment int64
+	// DeploymentID orders states of the service, an older deployment never replaces a newer one
+	DeploymentID   int64
+	Ref            string
+	SHA            string
+	Status         string
+	Deployer       string
+	CommitTitle    string
+	DeployableURL  string
+	PipelineID     int64
+	PipelineStatus string
+	FinishedAt     *time.Time
+	ExternalURL    string
+	UpdatedAt      time.Time
+}
+
+// ContourService is a service together with the contour and the application it belongs to
+type ContourService struct {
+	AppID     string
+	ContourID string
+	// Provider type of the service, gitlab if empty
+	Provider string
+	Service  *contours.ServiceInfo
+}
+
+// DeploymentStateStore represents methods to store deployment states
+type DeploymentStateStore interface {
+	FindServices(context.Context, int64) ([]*ContourService, error)
+	ListAllServices(context.Context) ([]*ContourService, error)
+	Upsert(context.Context, *DeploymentState) error
+	UpdatePipeline(context.Context, string, string, string, int64, string) error
+	ListByContour(context.Context, string) ([]*DeploymentState, error)
+}
+
+// DeploymentStateRepo implements DeploymentStateStore
+type DeploymentStateRepo struct {
+	Pool      *pgxpool.Conn
+	CreatedAt time.Time
+}
+
+// FindServices of all contours that point to the project
+func (store DeploymentStateRepo) FindServices(ctx context.Context, project int64) ([]*ContourService, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT c.application_id, c.id, COALESCE(c.service_providers->>(obj.val->>'id'), ''), obj.val FROM contours c
+	JOIN LATERAL jsonb_array_elements(c.services) obj(val)
+	  ON (obj.val->>'project')::BIGINT = $1;`
+	return store.queryServices(ctx, sql, project)
+}
+
+// ListAllServices of all contours
+func (store DeploymentStateRepo) ListAllServices(ctx context.Context) ([]*ContourService, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT c.application_id, c.id, COALESCE(c.service_providers->>(obj.val->>'id'), ''), obj.val FROM contours c
+	JOIN LATERAL jsonb_array_elements(c.services) obj(val) ON TRUE;`
+	return store.queryServices(ctx, sql)
+}
+
+func (store DeploymentStateRepo) queryServices(ctx context.Context, sql string, args ...interface{}) ([]*ContourService, error) {
+	var (
+		log      = logger.GetGrpcLogger(ctx)
+		services []*ContourService
+	)
+	rows, err := store.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	for rows.Next() {
+		service := &ContourService{Service: &contours.ServiceInfo{}}
+		if err := rows.Scan(&service.AppID, &service.ContourID, &service.Provider, service.Service); err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		services = append(services, service)
+	}
+	return services, nil
+}
+
+// Upsert the deployment part of a service state, pipeline fields are kept as they are.
+// An empty ref or deployable url keeps the stored one only while the same commit is deployed.
+// Hooks and polls may arrive out of order, so a state of an older deployment of the same
+// environment is ignored
+func (store DeploymentStateRepo) Upsert(ctx context.Context, state *DeploymentState) error {
+	defer store.Pool.Release()
+	const sql = `INSERT INTO service_deployment_state
+	(contour_id, service_id, project, environment, deployment_id, ref, sha, status, deployer, commit_title, deployable_url, finished_at, external_url, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	ON CONFLICT (contour_id, service_id) DO UPDATE SET
+	  project = EXCLUDED.project,
+	  environment = EXCLUDED.environment,
+	  deployment_id = EXCLUDED.deployment_id,
+	  ref = CASE WHEN EXCLUDED.ref = '' AND EXCLUDED.sha = service_deployment_state.sha
+	    THEN service_deployment_state.ref ELSE EXCLUDED.ref END,
+	  sha = EXCLUDED.sha,
+	  status = EXCLUDED.status,
+	  deployer = EXCLUDED.deployer,
+	  commit_title = EXCLUDED.commit_title,
+	  deployable_url = CASE WHEN EXCLUDED.deployable_url = '' AND EXCLUDED.sha = service_deployment_state.sha
+	    THEN service_deployment_state.deployable_url ELSE EXCLUDED.deployable_url END,
+	  finished_at = EXCLUDED.finished_at,
+	  external_url = COALESCE(NULLIF(EXCLUDED.external_url, ''), service_deployment_state.external_url),
+	  updated_at = EXCLUDED.updated_at
+	WHERE EXCLUDED.deployment_id >= service_deployment_state.deployment_id
+	  OR EXCLUDED.project <> service_deployment_state.project
+	  OR EXCLUDED.environment <> service_deployment_state.environment;`
+	var log = logger.GetGrpcLogger(ctx)
+	_, err := store.Pool.Exec(ctx, sql,
+		state.ContourID, state.ServiceID, state.Project, state.Environment, state.DeploymentID,
+		state.Ref, state.SHA, state.Status, state.Deployer, state.CommitTitle, state.DeployableURL,
+		state.FinishedAt, state.ExternalURL, state.UpdatedAt,
+	)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// UpdatePipeline sets the pipeline of a service state if the service is deployed from the sha.
+// States stored from hooks before full shas were kept have short ones, so it's matched as a prefix
+func (store DeploymentStateRepo) UpdatePipeline(ctx context.Context, contourID, serviceID, sha string, pipelineID int64, pipelineStatus string) error {
+	defer store.Pool.Release()
+	const sql = `UPDATE service_deployment_state
+	SET pipeline_id = $4, pipeline_status = $5, updated_at = now()
+	WHERE contour_id = $1 AND service_id = $2 AND sha <> '' AND $3 LIKE sha || '%';`
+	var log = logger.GetGrpcLogger(ctx)
+	_, err := store.Pool.Exec(ctx, sql, contourID, serviceID, sha, pipelineID, pipelineStatus)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// ListByContour returns stored states of all contour services
+func (store DeploymentStateRepo) ListByContour(ctx context.Context, contourID string) ([]*DeploymentState, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT contour_id, service_id, project, environment,
+	COALESCE(ref, ''), COALESCE(sha, ''), COALESCE(status, ''), COALESCE(deployer, ''),
+	COALESCE(commit_title, ''), COALESCE(deployable_url, ''),
+	COALESCE(pipeline_id, 0), COALESCE(pipeline_status, ''),
+	finished_at, COALESCE(external_url, ''), updated_at
+	FROM service_deployment_state WHERE contour_id = $1`
+	var (
+		log    = logger.GetGrpcLogger(ctx)
+		states []*DeploymentState
+	)
+	rows, err := store.Pool.Query(ctx, sql, contourID)
+	if err != nil {
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	for rows.Next() {
+		state := &DeploymentState{}
+		err = rows.Scan(&state.ContourID, &state.ServiceID, &state.Project, &state.Environment,
+			&state.Ref, &state.SHA, &state.Status, &state.Deployer,
+			&state.CommitTitle, &state.DeployableURL,
+			&state.PipelineID, &state.PipelineStatus,
+			&state.FinishedAt, &state.ExternalURL, &state.UpdatedAt,
+		)
+		if err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
@@ -0,0 +1,182 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rule of an application to create contours for merge requests
+type Rule struct {
+	AppID string
+	// Projects whose merge requests get a contour, every one of them becomes a service
+	Projects []int64
+	// EnvironmentPattern names environments of services, e.g. review/{branch}
+	EnvironmentPattern string
+}
+
+// Contour created for a merge request
+type Contour struct {
+	AppID       string
+	Project     int64
+	IID         int64
+	ContourID   string
+	ContourName string
+	// Branch is the source branch of the merge request, empty for contours created before it was stored
+	Branch string
+}
+
+// EphemeralStore represents methods to store rules and contours created by them
+type EphemeralStore interface {
+	SetRule(context.Context, *Rule) error
+	GetRule(context.Context, string) (*Rule, error)
+	DeleteRule(context.Context, string) error
+	FindRules(context.Context, int64) ([]*Rule, error)
+	AddContour(context.Context, *Contour) error
+	FindContours(context.Context, int64, int64) ([]*Contour, error)
+	ListContours(context.Context, string) ([]*Contour, error)
+}
+
+// EphemeralRepo implements EphemeralStore
+type EphemeralRepo struct {
+	Pool      *pgxpool.Conn
+	CreatedAt time.Time
+}
+
+// SetRule of an application, the previous one is replaced
+func (store EphemeralRepo) SetRule(ctx context.Context, rule *Rule) error {
+	defer store.Pool.Release()
+	const sql = `INSERT INTO ephemeral_contour_rules (application_id, projects, environment_pattern)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (application_id) DO UPDATE SET
+	  projects = EXCLUDED.projects,
+	  environment_pattern = EXCLUDED.environment_pattern;`
+	var log = logger.GetGrpcLogger(ctx)
+	_, err := store.Pool.Exec(ctx, sql, rule.AppID, rule.Projects, rule.EnvironmentPattern)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// GetRule of an application
+func (store EphemeralRepo) GetRule(ctx context.Context, appID string) (*Rule, error) {
+	defer store.Pool.Release()
+	const sql = "SELECT application_id, projects, environment_pattern FROM ephemeral_contour_rules WHERE application_id = $1"
+	var (
+		log  = logger.GetGrpcLogger(ctx)
+		rule = &Rule{}
+	)
+	err := store.Pool.QueryRow(ctx, sql, appID).Scan(&rule.AppID, &rule.Projects, &rule.EnvironmentPattern)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("application %s has no ephemeral contours rule", appID))
+		}
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return rule, nil
+}
+
+// DeleteRule of an application, contours created by it are kept
+func (store EphemeralRepo) DeleteRule(ctx context.Context, appID string) error {
+	defer store.Pool.Release()
+	const sql = "DELETE FROM ephemeral_contour_rules WHERE application_id = $1"
+	var log = logger.GetGrpcLogger(ctx)
+	_, err := store.Pool.Exec(ctx, sql, appID)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// FindRules that include the project
+func (store EphemeralRepo) FindRules(ctx context.Context, project int64) ([]*Rule, error) {
+	defer store.Pool.Release()
+	const sql = "SELECT application_id, projects, environment_pattern FROM ephemeral_contour_rules WHERE $1 = ANY(projects)"
+	var (
+		log   = logger.GetGrpcLogger(ctx)
+		rules []*Rule
+	)
+	rows, err := store.Pool.Query(ctx, sql, project)
+	if err != nil {
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	for rows.Next() {
+		rule := &Rule{}
+		if err := rows.Scan(&rule.AppID, &rule.Projects, &rule.EnvironmentPattern); err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// AddContour of a merge request, returns AlreadyExists if the merge request has one
+func (store EphemeralRepo) AddContour(ctx context.Context, contour *Contour) error {
+	defer store.Pool.Release()
+	const sql = `INSERT INTO ephemeral_contours (application_id, project, iid, contour_id, branch)
+	VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+	var log = logger.GetGrpcLogger(ctx)
+	tag, err := store.Pool.Exec(ctx, sql, contour.AppID, contour.Project, contour.IID, contour.ContourID, contour.Branch)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.AlreadyExists, fmt.Sprintf("merge request !%d of the project %d already has a contour", contour.IID, contour.Project))
+	}
+	return nil
+}
+
+// FindContours created for a merge request
+func (store EphemeralRepo) FindContours(ctx context.Context, project, iid int64) ([]*Contour, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT e.application_id, e.project, e.iid, e.contour_id, c.name, COALESCE(e.branch, '')
+	FROM ephemeral_contours e JOIN contours c ON c.id = e.contour_id
+	WHERE e.project = $1 AND e.iid = $2`
+	return store.queryContours(ctx, sql, project, iid)
+}
+
+// ListContours created for merge requests in an application
+func (store EphemeralRepo) ListContours(ctx context.Context, appID string) ([]*Contour, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT e.application_id, e.project, e.iid, e.contour_id, c.name, COALESCE(e.branch, '')
+	FROM ephemeral_contours e JOIN contours c ON c.id = e.contour_id
+	WHERE e.application_id = $1`
+	return store.queryContours(ctx, sql, appID)
+}
+
+func (store EphemeralRepo) queryContours(ctx context.Context, sql string, args ...interface{}) ([]*Contour, error) {
+	var (
+		log      = logger.GetGrpcLogger(ctx)
+		contours []*Contour
+	)
+	rows, err := store.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	for rows.Next() {
+		contour := &Contour{}
+		err = rows.Scan(&contour.AppID, &contour.Project, &contour.IID, &contour.ContourID, &contour.ContourName, &contour.Branch)
+		if err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		contours = append(contours, contour)
+	}
+	return contours, nil
+}
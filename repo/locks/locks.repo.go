@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Lock of a contour service environment
+type Lock struct {
+	ContourID   string
+	ServiceID   string
+	Project     int64
+	Environment string
+	// PreviousProtection is nil if the environment wasn't protected before the lock
+	PreviousProtection *gitlab.ProtectedEnvironment
+	LockedAt           time.Time
+}
+
+// LockStore represents methods to store locks
+type LockStore interface {
+	Add(context.Context, *Lock) error
+	Get(context.Context, string, string) (*Lock, error)
+	Remove(context.Context, string, string) error
+	Locked(context.Context, string) (bool, error)
+}
+
+// LockRepo implements LockStore
+type LockRepo struct {
+	Pool      *pgxpool.Conn
+	CreatedAt time.Time
+}
+
+// Add a lock unless the service is locked already, so the protection
+// from before the first lock is never overwritten
+func (store LockRepo) Add(ctx context.Context, lock *Lock) error {
+	defer store.Pool.Release()
+	const sql = `INSERT INTO contour_locks (contour_id, service_id, project, environment, previous_protection, locked_at)
+	VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING`
+	var (
+		log      = logger.GetGrpcLogger(ctx)
+		previous []byte
+	)
+	if lock.PreviousProtection != nil {
+		var err error
+		if previous, err = json.Marshal(lock.PreviousProtection); err != nil {
+			log.Error(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	_, err := store.Pool.Exec(ctx, sql, lock.ContourID, lock.ServiceID, lock.Project, lock.Environment, previous, store.CreatedAt)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// Get the lock of a contour service
+func (store LockRepo) Get(ctx context.Context, contourID, serviceID string) (*Lock, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT contour_id, service_id, project, environment, previous_protection, locked_at
+	FROM contour_locks WHERE contour_id = $1 AND service_id = $2`
+	var (
+		log      = logger.GetGrpcLogger(ctx)
+		lock     = &Lock{}
+		previous []byte
+	)
+	err := store.Pool.QueryRow(ctx, sql, contourID, serviceID).
+		Scan(&lock.ContourID, &lock.ServiceID, &lock.Project, &lock.Environment, &previous, &lock.LockedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("service %s of the contour %s isn't locked", serviceID, contourID))
+		}
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if previous != nil {
+		lock.PreviousProtection = &gitlab.ProtectedEnvironment{}
+		if err := json.Unmarshal(previous, lock.PreviousProtection); err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return lock, nil
+}
+
+// Remove the lock of a contour service
+func (store LockRepo) Remove(ctx context.Context, contourID, serviceID string) error {
+	defer store.Pool.Release()
+	const sql = "DELETE FROM contour_locks WHERE contour_id = $1 AND service_id = $2"
+	var log = logger.GetGrpcLogger(ctx)
+	_, err := store.Pool.Exec(ctx, sql, contourID, serviceID)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// Locked tells if any service of a contour is locked
+func (store LockRepo) Locked(ctx context.Context, contourID string) (bool, error) {
+	defer store.Pool.Release()
+	const sql = "SELECT EXISTS (SELECT 1 FROM contour_locks WHERE contour_id = $1)"
+	var (
+		log    = logger.GetGrpcLogger(ctx)
+		locked bool
+	)
+	err := store.Pool.QueryRow(ctx, sql, contourID).Scan(&locked)
+	if err != nil {
+		log.Error(err)
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	return locked, nil
+}
@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MergeRequestLink of a merge request to a contour
+type MergeRequestLink struct {
+	ContourID string
+	Project   int64
+	IID       int64
+	Title     string
+	WebURL    string
+	// NoteID of the comment about the contour, 0 if it's not posted yet
+	NoteID    int64
+	CreatedAt time.Time
+}
+
+// MergeRequestLinkStore represents methods to store merge request links
+type MergeRequestLinkStore interface {
+	Add(context.Context, *MergeRequestLink) error
+	List(context.Context, string) ([]*MergeRequestLink, error)
+	SetNote(context.Context, *MergeRequestLink, int64) error
+}
+
+// MergeRequestLinkRepo implements MergeRequestLinkStore
+type MergeRequestLinkRepo struct {
+	Pool      *pgxpool.Conn
+	CreatedAt time.Time
+}
+
+// Add a link, linking the same merge request again only refreshes its title and url
+func (store MergeRequestLinkRepo) Add(ctx context.Context, link *MergeRequestLink) error {
+	defer store.Pool.Release()
+	const sql = `INSERT INTO contour_merge_requests (contour_id, project, iid, title, web_url, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (contour_id, project, iid) DO UPDATE SET
+	  title = EXCLUDED.title,
+	  web_url = EXCLUDED.web_url
+	RETURNING COALESCE(note_id, 0), created_at;`
+	var log = logger.GetGrpcLogger(ctx)
+	err := store.Pool.QueryRow(ctx, sql, link.ContourID, link.Project, link.IID, link.Title, link.WebURL, store.CreatedAt).
+		Scan(&link.NoteID, &link.CreatedAt)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// List merge requests linked to a contour, newest links first
+func (store MergeRequestLinkRepo) List(ctx context.Context, contourID string) ([]*MergeRequestLink, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT contour_id, project, iid, COALESCE(title, ''), COALESCE(web_url, ''), COALESCE(note_id, 0), created_at
+	FROM contour_merge_requests WHERE contour_id = $1 ORDER BY created_at DESC`
+	var (
+		log   = logger.GetGrpcLogger(ctx)
+		links []*MergeRequestLink
+	)
+	rows, err := store.Pool.Query(ctx, sql, contourID)
+	if err != nil {
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	for rows.Next() {
+		link := &MergeRequestLink{}
+		err = rows.Scan(&link.ContourID, &link.Project, &link.IID, &link.Title, &link.WebURL, &link.NoteID, &link.CreatedAt)
+		if err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+// SetNote stores the id of the comment posted on the merge request unless another comment
+// was stored since the previous one was read. The stored id is set back to the link,
+// so concurrent updates agree on one comment
+func (store MergeRequestLinkRepo) SetNote(ctx context.Context, link *MergeRequestLink, previous int64) error {
+	defer store.Pool.Release()
+	const sql = `UPDATE contour_merge_requests
+	SET note_id = CASE WHEN COALESCE(note_id, 0) = $5 THEN $4 ELSE note_id END
+	WHERE contour_id = $1 AND project = $2 AND iid = $3
+	RETURNING COALESCE(note_id, 0)`
+	var log = logger.GetGrpcLogger(ctx)
+	err := store.Pool.QueryRow(ctx, sql, link.ContourID, link.Project, link.IID, link.NoteID, previous).Scan(&link.NoteID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return status.Error(codes.NotFound, fmt.Sprintf("merge request !%d of the project %d isn't linked to the contour %s", link.IID, link.Project, link.ContourID))
+		}
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
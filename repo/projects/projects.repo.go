@@ -0,0 +1,131 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceProject is the last known path of the project a contour service points to
+type ServiceProject struct {
+	AppID     string
+	ContourID string
+	ServiceID string
+	// Provider type of the service, gitlab if empty
+	Provider string
+	Project  int64
+	Path     string
+}
+
+// ServiceProjectStore represents methods to store project paths of services
+type ServiceProjectStore interface {
+	Set(context.Context, []*ServiceProject) error
+	ListAll(context.Context) ([]*ServiceProject, error)
+	Rename(context.Context, *ServiceProject, string) error
+}
+
+// ServiceProjectRepo implements ServiceProjectStore
+type ServiceProjectRepo struct {
+	Pool      *pgxpool.Conn
+	CreatedAt time.Time
+}
+
+// Set paths of service projects
+func (store ServiceProjectRepo) Set(ctx context.Context, projects []*ServiceProject) error {
+	defer store.Pool.Release()
+	return SetInTx(ctx, store.Pool, projects, store.CreatedAt)
+}
+
+// SetInTx sets paths of service projects with the connection or the transaction
+// of another store, so they are stored together with its changes
+func SetInTx(ctx context.Context, db execer, projects []*ServiceProject, updatedAt time.Time) error {
+	const sql = `INSERT INTO service_projects (contour_id, service_id, project, path, updated_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (contour_id, service_id) DO UPDATE SET
+	  project = EXCLUDED.project,
+	  path = EXCLUDED.path,
+	  updated_at = EXCLUDED.updated_at;`
+	var log = logger.GetGrpcLogger(ctx)
+	for _, project := range projects {
+		_, err := db.Exec(ctx, sql, project.ContourID, project.ServiceID, project.Project, project.Path, updatedAt)
+		if err != nil {
+			log.Error(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+// execer is implemented by both pool connections and transactions
+type execer interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+}
+
+// ListAll paths of services that are still in their contours
+func (store ServiceProjectRepo) ListAll(ctx context.Context) ([]*ServiceProject, error) {
+	defer store.Pool.Release()
+	const sql = `SELECT c.application_id, p.contour_id, p.service_id,
+	COALESCE(c.service_providers->>p.service_id, ''), p.project, COALESCE(p.path, '')
+	FROM service_projects p
+	JOIN contours c ON c.id = p.contour_id
+	WHERE EXISTS (SELECT 1 FROM jsonb_array_elements(c.services) obj(val) WHERE obj.val->>'id' = p.service_id);`
+	var (
+		log      = logger.GetGrpcLogger(ctx)
+		projects []*ServiceProject
+	)
+	rows, err := store.Pool.Query(ctx, sql)
+	if err != nil {
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	for rows.Next() {
+		project := &ServiceProject{}
+		err = rows.Scan(&project.AppID, &project.ContourID, &project.ServiceID,
+			&project.Provider, &project.Project, &project.Path,
+		)
+		if err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+// Rename the project of a service and write an audit entry in one transaction
+func (store ServiceProjectRepo) Rename(ctx context.Context, project *ServiceProject, path string) error {
+	defer store.Pool.Release()
+	const (
+		updateSQL = "UPDATE service_projects SET path = $3, updated_at = $4 WHERE contour_id = $1 AND service_id = $2"
+		auditSQL  = `INSERT INTO service_project_renames (contour_id, service_id, project, old_path, new_path, renamed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+	)
+	var log = logger.GetGrpcLogger(ctx)
+	tx, err := store.Pool.Begin(ctx)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx, updateSQL, project.ContourID, project.ServiceID, path, store.CreatedAt)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	_, err = tx.Exec(ctx, auditSQL, project.ContourID, project.ServiceID, project.Project, project.Path, path, store.CreatedAt)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := tx.Commit(ctx); err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Variable of a contour, the value is stored encrypted
+type Variable struct {
+	Key            string
+	EncryptedValue []byte
+	Masked         bool
+}
+
+// VariableStore represents methods to store contour variables
+type VariableStore interface {
+	Set(context.Context, string, []*Variable) error
+	Remove(context.Context, string, []string) error
+	List(context.Context, string) ([]*Variable, error)
+}
+
+// VariableRepo implements VariableStore
+type VariableRepo struct {
+	Pool      *pgxpool.Conn
+	CreatedAt time.Time
+}
+
+// Set variables of a contour, existing keys are overwritten
+func (store VariableRepo) Set(ctx context.Context, contourID string, variables []*Variable) error {
+	defer store.Pool.Release()
+	const sql = `INSERT INTO contour_variables (contour_id, key, value, masked)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (contour_id, key) DO UPDATE SET
+	  value = EXCLUDED.value,
+	  masked = EXCLUDED.masked;`
+	var log = logger.GetGrpcLogger(ctx)
+	for _, variable := range variables {
+		_, err := store.Pool.Exec(ctx, sql, contourID, variable.Key, variable.EncryptedValue, variable.Masked)
+		if err != nil {
+			log.Error(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+// Remove variables of a contour by their keys
+func (store VariableRepo) Remove(ctx context.Context, contourID string, keys []string) error {
+	defer store.Pool.Release()
+	const sql = "DELETE FROM contour_variables WHERE contour_id = $1 AND key = ANY($2)"
+	var log = logger.GetGrpcLogger(ctx)
+	_, err := store.Pool.Exec(ctx, sql, contourID, keys)
+	if err != nil {
+		log.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// List variables of a contour ordered by their keys
+func (store VariableRepo) List(ctx context.Context, contourID string) ([]*Variable, error) {
+	defer store.Pool.Release()
+	const sql = "SELECT key, value, masked FROM contour_variables WHERE contour_id = $1 ORDER BY key"
+	var (
+		log       = logger.GetGrpcLogger(ctx)
+		variables []*Variable
+	)
+	rows, err := store.Pool.Query(ctx, sql, contourID)
+	if err != nil {
+		log.Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	for rows.Next() {
+		variable := &Variable{}
+		if err := rows.Scan(&variable.Key, &variable.EncryptedValue, &variable.Masked); err != nil {
+			log.Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		variables = append(variables, variable)
+	}
+	return variables, nil
+}
@@ -106,3 +106,78 @@ func (s *applicationsGrpcImpl) List(in *applications.ListOptions, stream applica
 	}
 	return nil
 }
+
+func (s *applicationsGrpcImpl) SetGitlabConnection(ctx context.Context, in *applications.GitlabConnection) (*applications.ConnectionUser, error) {
+	logger.EnpointHit(ctx)
+	if err := checkAppRight(ctx, &applications.AppId{Id: in.GetAppId()}, rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return SetGitlabConnection(ctx, in)
+}
+
+func (s *applicationsGrpcImpl) TestGitlabConnection(ctx context.Context, in *applications.AppId) (*applications.ConnectionUser, error) {
+	logger.EnpointHit(ctx)
+	if err := checkAppRight(ctx, in, rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return TestGitlabConnection(ctx, in)
+}
+
+func (s *applicationsGrpcImpl) RotateGitlabToken(ctx context.Context, in *applications.AppIdAndToken) (*applications.ConnectionUser, error) {
+	logger.EnpointHit(ctx)
+	if err := checkAppRight(ctx, &applications.AppId{Id: in.GetAppId()}, rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return RotateGitlabToken(ctx, in)
+}
+
+func (s *applicationsGrpcImpl) SetGithubConnection(ctx context.Context, in *applications.GithubConnection) (*applications.ConnectionUser, error) {
+	logger.EnpointHit(ctx)
+	if err := checkAppRight(ctx, &applications.AppId{Id: in.GetAppId()}, rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return SetGithubConnection(ctx, in)
+}
+
+func (s *applicationsGrpcImpl) SetEphemeralContourRule(ctx context.Context, in *applications.EphemeralContourRule) (*common.EmptyMessage, error) {
+	logger.EnpointHit(ctx)
+	if err := checkAppRight(ctx, &applications.AppId{Id: in.GetAppId()}, rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return SetEphemeralContourRule(ctx, in)
+}
+
+func (s *applicationsGrpcImpl) GetEphemeralContourRule(ctx context.Context, in *applications.AppId) (*applications.EphemeralContourRule, error) {
+	logger.EnpointHit(ctx)
+	if err := checkAppRight(ctx, in, rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return GetEphemeralContourRule(ctx, in)
+}
+
+func (s *applicationsGrpcImpl) DeleteEphemeralContourRule(ctx context.Context, in *applications.AppId) (*common.EmptyMessage, error) {
+	logger.EnpointHit(ctx)
+	if err := checkAppRight(ctx, in, rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return DeleteEphemeralContourRule(ctx, in)
+}
+
+func (s *applicationsGrpcImpl) WhereIsDeployed(ctx context.Context, in *applications.DeployedCommitQuery) (*applications.DeployedContours, error) {
+	logger.EnpointHit(ctx)
+	return WhereIsDeployed(ctx, in)
+}
+
+// checkAppRight checks that the caller has the right on the application
+func checkAppRight(ctx context.Context, appID *applications.AppId, right rights.AccessRights) error {
+	ctx = metadata.MetadataInternalProxy(ctx)
+	_, err := grpcusers.AuthorizationClient.ValidateToken(ctx, &common.EmptyMessage{})
+	if err != nil {
+		return err
+	}
+	_, err = grpcusers.RightsClient.CheckRight(ctx, &rights.AccessRightRequest{
+		ApplicationId: appID,
+		AccessRight:   right,
+	})
+	return err
+}
@@ -21,9 +21,8 @@ import (
 // var apprepo repo.ApplicationStore
 
 var initRepo = func(ctx context.Context) repo.ApplicationStore {
-	conn := postgres.Pool(ctx)
 	apprepo := repo.ApplicationRepo{
-		Pool:      conn,
+		Pool:      postgres.Pool,
 		CreatedAt: time.Now(),
 	}
 	return apprepo
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	grpcusers "github.com/badhouseplants/envspotting-apps/internal/grpc-users"
+	deploymentsRepo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-apps/tools/metadata"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"github.com/badhouseplants/envspotting-go-proto/models/common"
+	"github.com/badhouseplants/envspotting-go-proto/models/users/rights"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var initStateRepo = func(ctx context.Context) deploymentsRepo.DeploymentStateStore {
+	return deploymentsRepo.DeploymentStateRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// WhereIsDeployed returns every contour of applications available to the caller
+// whose environment of the project has a deployment containing the commit.
+// Services whose deployments can't be checked are returned with an error
+func WhereIsDeployed(ctx context.Context, query *applications.DeployedCommitQuery) (*applications.DeployedContours, error) {
+	if err := validateDeployedCommitQuery(query); err != nil {
+		return nil, err
+	}
+	log := logger.GetGrpcLogger(ctx)
+	appIDs, err := availableApps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Applications sharing a gitlab connection see the same project id and the same commit,
+	// so both are resolved once per connection
+	var (
+		found    = &applications.DeployedContours{}
+		commits  = map[gitlabClient.Connection]*resolvedCommit{}
+		services = map[int64][]*deploymentsRepo.ContourService{}
+	)
+	for _, appID := range appIDs {
+		// One application with a broken connection shouldn't hide the others
+		conn, err := gitlabConnection(ctx, &applications.AppId{Id: appID})
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		git, err := gitlabClient.NewClient(conn)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		git = git.WithContext(ctx)
+		commit, ok := commits[*conn]
+		if !ok {
+			if commit, err = resolveCommit(git, query); err != nil {
+				// Failures aren't cached, the next application of this connection tries again
+				log.Error(err)
+				continue
+			}
+			commits[*conn] = commit
+		}
+		if commit == nil {
+			continue
+		}
+		projectServices, ok := services[commit.project]
+		if !ok {
+			if projectServices, err = initStateRepo(ctx).FindServices(ctx, commit.project); err != nil {
+				return nil, err
+			}
+			services[commit.project] = projectServices
+		}
+		for _, service := range projectServices {
+			if service.AppID != appID {
+				continue
+			}
+			if providerType, _ := scm.ValidateType(service.Provider); providerType != scm.ProviderGitlab {
+				continue
+			}
+			contour := &applications.DeployedContour{
+				AppId:       service.AppID,
+				ContourId:   service.ContourID,
+				ServiceId:   service.Service.GetId(),
+				Project:     service.Service.GetProject(),
+				Environment: service.Service.GetEnvironment(),
+				CommitSha:   commit.sha,
+			}
+			contour.DeployedSha, err = deployedCommit(git, service, commit.sha)
+			if err != nil {
+				contour.Error = err.Error()
+			} else if contour.DeployedSha == "" {
+				continue
+			}
+			found.Contours = append(found.Contours, contour)
+		}
+	}
+	return found, nil
+}
+
+func validateDeployedCommitQuery(query *applications.DeployedCommitQuery) error {
+	if query.GetProjectPath() == "" {
+		return status.Error(codes.InvalidArgument, "project path is required")
+	}
+	refs := 0
+	for _, set := range []bool{query.GetSha() != "", query.GetTag() != "", query.GetMergeRequestIid() != 0} {
+		if set {
+			refs++
+		}
+	}
+	if refs != 1 {
+		return status.Error(codes.InvalidArgument, "exactly one of sha, tag or merge request iid must be set")
+	}
+	return nil
+}
+
+// availableApps returns ids of applications the caller can read
+func availableApps(ctx context.Context) ([]string, error) {
+	ctx = metadata.MetadataInternalProxy(ctx)
+	if _, err := grpcusers.AuthorizationClient.ValidateToken(ctx, &common.EmptyMessage{}); err != nil {
+		return nil, err
+	}
+	userID, err := grpcusers.AuthorizationClient.ParseIdFromToken(ctx, &common.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+	stream, err := grpcusers.RightsClient.ListAvailableApps(ctx, &rights.AvailableAppsListOptions{AccountId: userID})
+	if err != nil {
+		return nil, err
+	}
+	var appIDs []string
+	for {
+		apps, err := stream.Recv()
+		if err == io.EOF {
+			return appIDs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		appIDs = append(appIDs, apps.GetApplicationId().GetId())
+	}
+}
+
+// resolvedCommit of a query in one gitlab instance
+type resolvedCommit struct {
+	project int64
+	sha     string
+}
+
+// resolveCommit returns nil if the project or the commit doesn't exist in this gitlab
+func resolveCommit(git *gitlabClient.Client, query *applications.DeployedCommitQuery) (*resolvedCommit, error) {
+	project, err := git.FindProject(query.GetProjectPath())
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	ref := query.GetSha()
+	if query.GetTag() != "" {
+		ref = query.GetTag()
+	}
+	if query.GetMergeRequestIid() != 0 {
+		mr, err := git.GetMergeRequest(int64(project.ID), query.GetMergeRequestIid())
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		switch {
+		case mr.MergeCommitSHA != "":
+			ref = mr.MergeCommitSHA
+		case mr.SquashCommitSHA != "":
+			ref = mr.SquashCommitSHA
+		default:
+			ref = mr.SHA
+		}
+	}
+	commit, err := git.GetCommit(int64(project.ID), ref)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &resolvedCommit{project: int64(project.ID), sha: commit.ID}, nil
+}
+
+// deployedCommit returns the sha deployed to the service environment if it contains the commit,
+// and an empty string otherwise
+func deployedCommit(git *gitlabClient.Client, service *deploymentsRepo.ContourService, sha string) (string, error) {
+	env, err := git.GetEnvironment(service.Service.GetProject(), service.Service.GetEnvironment())
+	if status.Code(err) == codes.NotFound {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	if env.LastDeployment == nil {
+		return "", nil
+	}
+	deployed := env.LastDeployment.SHA
+	if deployed == sha {
+		return deployed, nil
+	}
+	// The commit is in the deployment if nothing of it is missing there
+	compare, err := git.Compare(service.Service.GetProject(), deployed, sha)
+	if err != nil {
+		return "", err
+	}
+	if len(compare.Commits) != 0 {
+		return "", nil
+	}
+	return deployed, nil
+}
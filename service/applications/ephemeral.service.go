@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	ephemeralRepo "github.com/badhouseplants/envspotting-apps/repo/ephemeral"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"github.com/badhouseplants/envspotting-go-proto/models/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Placeholders of an environment pattern
+const (
+	PatternBranch     = "{branch}"
+	PatternBranchSlug = "{branch_slug}"
+	PatternIID        = "{iid}"
+)
+
+// gitlab limits CI_COMMIT_REF_SLUG to that
+const maxSlugLength = 63
+
+var slugRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+var initEphemeralRepo = func(ctx context.Context) ephemeralRepo.EphemeralStore {
+	return ephemeralRepo.EphemeralRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// SetEphemeralContourRule of an application, projects must exist in its gitlab
+func SetEphemeralContourRule(ctx context.Context, rule *applications.EphemeralContourRule) (*common.EmptyMessage, error) {
+	if len(rule.GetProjects()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "rule must have at least one project")
+	}
+	pattern := rule.GetEnvironmentPattern()
+	if !strings.Contains(pattern, PatternBranch) &&
+		!strings.Contains(pattern, PatternBranchSlug) &&
+		!strings.Contains(pattern, PatternIID) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("environment pattern must contain %s, %s or %s", PatternBranch, PatternBranchSlug, PatternIID))
+	}
+	git, err := GitlabClient(ctx, &applications.AppId{Id: rule.GetAppId()})
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range rule.GetProjects() {
+		_, err := git.GetProject(project)
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("project can't be found in gitlab: %d", project))
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	err = initEphemeralRepo(ctx).SetRule(ctx, &ephemeralRepo.Rule{
+		AppID:              rule.GetAppId(),
+		Projects:           rule.GetProjects(),
+		EnvironmentPattern: pattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &common.EmptyMessage{}, nil
+}
+
+// GetEphemeralContourRule of an application
+func GetEphemeralContourRule(ctx context.Context, appID *applications.AppId) (*applications.EphemeralContourRule, error) {
+	rule, err := initEphemeralRepo(ctx).GetRule(ctx, appID.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &applications.EphemeralContourRule{
+		AppId:              rule.AppID,
+		Projects:           rule.Projects,
+		EnvironmentPattern: rule.EnvironmentPattern,
+	}, nil
+}
+
+// DeleteEphemeralContourRule of an application, contours already created stay until their merge requests are closed
+func DeleteEphemeralContourRule(ctx context.Context, appID *applications.AppId) (*common.EmptyMessage, error) {
+	if err := initEphemeralRepo(ctx).DeleteRule(ctx, appID.GetId()); err != nil {
+		return nil, err
+	}
+	return &common.EmptyMessage{}, nil
+}
+
+// EnvironmentName of a merge request by the pattern of a rule
+func EnvironmentName(pattern, branch string, iid int64) string {
+	return strings.NewReplacer(
+		PatternBranch, branch,
+		PatternBranchSlug, branchSlug(branch),
+		PatternIID, strconv.FormatInt(iid, 10),
+	).Replace(pattern)
+}
+
+// branchSlug is the branch name the way gitlab puts it into CI_COMMIT_REF_SLUG
+func branchSlug(branch string) string {
+	slug := slugRegexp.ReplaceAllString(strings.ToLower(branch), "-")
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+	}
+	return strings.Trim(slug, "-")
+}
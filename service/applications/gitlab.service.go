@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/applications"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-apps/tools/secrets"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetGitlabConnection of an application, the connection is tested before it's stored
+func SetGitlabConnection(ctx context.Context, in *applications.GitlabConnection) (*applications.ConnectionUser, error) {
+	if in.GetLockGroupId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "lock group id can't be negative")
+	}
+	if err := validateGitlabURL(in.GetUrl()); err != nil {
+		return nil, err
+	}
+	return storeGitlabConnection(ctx, &applications.AppId{Id: in.GetAppId()}, &gitlabClient.Connection{
+		URL:      in.GetUrl(),
+		Token:    in.GetToken(),
+		CABundle: in.GetCaBundle(),
+	}, in.GetLockGroupId())
+}
+
+// TestGitlabConnection of an application and return the user owning the token
+func TestGitlabConnection(ctx context.Context, appID *applications.AppId) (*applications.ConnectionUser, error) {
+	git, err := GitlabClient(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := git.CurrentUser()
+	if err != nil {
+		return nil, err
+	}
+	return &applications.ConnectionUser{Username: user.Username}, nil
+}
+
+// RotateGitlabToken of an application keeping the rest of the connection
+func RotateGitlabToken(ctx context.Context, in *applications.AppIdAndToken) (*applications.ConnectionUser, error) {
+	appID := &applications.AppId{Id: in.GetAppId()}
+	conn, err := gitlabConnection(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := initRepo(ctx).GetGitlabConnection(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	conn.Token = in.GetToken()
+	return storeGitlabConnection(ctx, appID, conn, stored.LockGroupID)
+}
+
+// LockGroupID of the gitlab connection of an application, the group that can deploy to locked contours
+func LockGroupID(ctx context.Context, appID *applications.AppId) (int64, error) {
+	stored, err := initRepo(ctx).GetGitlabConnection(ctx, appID)
+	if err != nil {
+		return 0, err
+	}
+	if stored.EncryptedToken == nil {
+		return 0, errNoGitlabConnection(appID)
+	}
+	groupID := stored.LockGroupID
+	if groupID == 0 {
+		return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("gitlab connection of the application %s has no lock group", appID.GetId()))
+	}
+	return groupID, nil
+}
+
+// GitlabClient for an application, applications without a connection can't use gitlab
+func GitlabClient(ctx context.Context, appID *applications.AppId) (*gitlabClient.Client, error) {
+	conn, err := gitlabConnection(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	git, err := gitlabClient.NewClient(conn)
+	if err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return git.WithContext(ctx), nil
+}
+
+// gitlabConnection of an application with a decrypted token
+func gitlabConnection(ctx context.Context, appID *applications.AppId) (*gitlabClient.Connection, error) {
+	stored, err := initRepo(ctx).GetGitlabConnection(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	if stored.EncryptedToken == nil {
+		return nil, errNoGitlabConnection(appID)
+	}
+	token, err := secrets.Decrypt(stored.EncryptedToken)
+	if err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &gitlabClient.Connection{
+		URL:      stored.URL,
+		Token:    string(token),
+		CABundle: stored.CABundle,
+	}, nil
+}
+
+func storeGitlabConnection(ctx context.Context, appID *applications.AppId, conn *gitlabClient.Connection, lockGroupID int64) (*applications.ConnectionUser, error) {
+	git, err := gitlabClient.NewClient(conn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err := git.WithContext(ctx).CurrentUser()
+	if err != nil {
+		return nil, err
+	}
+	token, err := secrets.Encrypt([]byte(conn.Token))
+	if err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	err = initRepo(ctx).SetGitlabConnection(ctx, appID, &repo.GitlabConnection{
+		URL:            conn.URL,
+		EncryptedToken: token,
+		CABundle:       conn.CABundle,
+		LockGroupID:    lockGroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &applications.ConnectionUser{Username: user.Username}, nil
+}
+
+func errNoGitlabConnection(appID *applications.AppId) error {
+	return status.Error(codes.FailedPrecondition, fmt.Sprintf("application %s has no gitlab connection", appID.GetId()))
+}
+
+// validateGitlabURL of a self-hosted instance, an empty url stands for gitlab.com
+func validateGitlabURL(raw string) error {
+	if raw == "" {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("gitlab url is invalid: %v", err))
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return status.Error(codes.InvalidArgument, "gitlab url must start with https:// or http://")
+	}
+	if u.Hostname() == "" || u.User != nil {
+		return status.Error(codes.InvalidArgument, "gitlab url must have a host and no credentials")
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/applications"
+	"github.com/badhouseplants/envspotting-apps/third_party/github"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-apps/tools/secrets"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetGithubConnection of an application, the connection is tested before it's stored
+func SetGithubConnection(ctx context.Context, in *applications.GithubConnection) (*applications.ConnectionUser, error) {
+	client, err := github.NewClient(&github.Connection{URL: in.GetUrl(), Token: in.GetToken()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err := client.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := secrets.Encrypt([]byte(in.GetToken()))
+	if err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	err = initRepo(ctx).SetGithubConnection(ctx, &applications.AppId{Id: in.GetAppId()}, &repo.GithubConnection{
+		URL:            in.GetUrl(),
+		EncryptedToken: token,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &applications.ConnectionUser{Username: user.Login}, nil
+}
+
+// GithubClient for an application.
+// Applications without a connection use api.github.com with the global github_token
+func GithubClient(ctx context.Context, appID *applications.AppId) (*github.Client, error) {
+	stored, err := initRepo(ctx).GetGithubConnection(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	conn := &github.Connection{Token: viper.GetString("github_token")}
+	if stored.EncryptedToken != nil {
+		token, err := secrets.Decrypt(stored.EncryptedToken)
+		if err != nil {
+			logger.GetGrpcLogger(ctx).Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		conn = &github.Connection{URL: stored.URL, Token: string(token)}
+	}
+	client, err := github.NewClient(conn)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return client, nil
+}
+
+// Provider of the type for an application
+func Provider(ctx context.Context, appID *applications.AppId, providerType string) (scm.Provider, error) {
+	providerType, err := scm.ValidateType(providerType)
+	if err != nil {
+		return nil, err
+	}
+	if providerType == scm.ProviderGithub {
+		client, err := GithubClient(ctx, appID)
+		if err != nil {
+			return nil, err
+		}
+		return scm.NewGithub(client), nil
+	}
+	git, err := GitlabClient(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	return scm.NewGitlab(git), nil
+}
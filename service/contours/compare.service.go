@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Shas shorter than that are too ambiguous to be matched by prefix
+const minSHALength = 7
+
+// Compare services of two contours matching them by gitlab project
+func Compare(ctx context.Context, in *contours.ContoursToCompare) (*contours.ContoursDrift, error) {
+	baseID := &contours.ContourId{Id: in.GetBaseContourId()}
+	targetID := &contours.ContourId{Id: in.GetTargetContourId()}
+	repo := initRepo(ctx)
+	base, err := repo.Get(ctx, baseID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := repo.Get(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	baseProviders, err := initProviders(ctx, baseID.GetId())
+	if err != nil {
+		return nil, err
+	}
+	targetProviders, err := initProviders(ctx, targetID.GetId())
+	if err != nil {
+		return nil, err
+	}
+	targetServices := servicesByProject(target)
+	var drifts []*contours.ProjectDrift
+	for _, baseService := range base.GetServices() {
+		targetService, ok := targetServices[baseService.GetProject()]
+		if !ok {
+			drifts = append(drifts, &contours.ProjectDrift{Project: baseService.GetProject(), State: contours.DriftState_DRIFT_STATE_ONLY_IN_BASE})
+			continue
+		}
+		delete(targetServices, baseService.GetProject())
+		baseGit, targetGit, err := gitlabPair(ctx, baseProviders, baseService, targetProviders, targetService)
+		if err != nil {
+			drifts = append(drifts, &contours.ProjectDrift{Project: baseService.GetProject(), Error: err.Error()})
+			continue
+		}
+		drifts = append(drifts, compareServices(baseGit, targetGit, baseService, targetService))
+	}
+	// Whatever is left is deployed in the target only
+	for _, targetService := range target.GetServices() {
+		if _, ok := targetServices[targetService.GetProject()]; ok {
+			drifts = append(drifts, &contours.ProjectDrift{Project: targetService.GetProject(), State: contours.DriftState_DRIFT_STATE_ONLY_IN_TARGET})
+			delete(targetServices, targetService.GetProject())
+		}
+	}
+	return &contours.ContoursDrift{Projects: drifts}, nil
+}
+
+// gitlabPair returns gitlab clients of services of two contours with the same project id.
+// Project ids are only unique within one gitlab instance, so services on different
+// instances point to different projects and can't be compared
+func gitlabPair(ctx context.Context, baseProviders *serviceProviders, base *contours.ServiceInfo, targetProviders *serviceProviders, target *contours.ServiceInfo) (*gitlabClient.Client, *gitlabClient.Client, error) {
+	baseGit, err := baseProviders.gitlab(ctx, base.GetId())
+	if err != nil {
+		return nil, nil, err
+	}
+	targetGit, err := targetProviders.gitlab(ctx, target.GetId())
+	if err != nil {
+		return nil, nil, err
+	}
+	baseHost, targetHost := baseGit.BaseURL().Host, targetGit.BaseURL().Host
+	if !strings.EqualFold(baseHost, targetHost) {
+		return nil, nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("project %d is on %s in one contour and on %s in the other, they are different projects", base.GetProject(), baseHost, targetHost))
+	}
+	return baseGit, targetGit, nil
+}
+
+// servicesByProject indexes contour services by project, the first service of a project wins
+func servicesByProject(contour *contours.ContourInfo) map[int64]*contours.ServiceInfo {
+	services := make(map[int64]*contours.ServiceInfo, len(contour.GetServices()))
+	for _, service := range contour.GetServices() {
+		if _, ok := services[service.GetProject()]; !ok {
+			services[service.GetProject()] = service
+		}
+	}
+	return services
+}
+
+// compareServices asks each contour's gitlab for its deployment, commits are compared in the base one
+func compareServices(baseGit, targetGit *gitlabClient.Client, base, target *contours.ServiceInfo) *contours.ProjectDrift {
+	drift := &contours.ProjectDrift{Project: base.GetProject()}
+	var err error
+	if drift.BaseSha, err = deployedSHA(baseGit, base); err != nil {
+		drift.Error = err.Error()
+		return drift
+	}
+	if drift.TargetSha, err = deployedSHA(targetGit, target); err != nil {
+		drift.Error = err.Error()
+		return drift
+	}
+	if sameCommit(drift.BaseSha, drift.TargetSha) {
+		drift.State = contours.DriftState_DRIFT_STATE_IDENTICAL
+		return drift
+	}
+	drift.State = contours.DriftState_DRIFT_STATE_DIFFERING
+	ahead, err := baseGit.Compare(base.GetProject(), drift.BaseSha, drift.TargetSha)
+	if err != nil {
+		drift.Error = err.Error()
+		return drift
+	}
+	behind, err := baseGit.Compare(base.GetProject(), drift.TargetSha, drift.BaseSha)
+	if err != nil {
+		drift.Error = err.Error()
+		return drift
+	}
+	drift.Ahead = int32(len(ahead.Commits))
+	drift.Behind = int32(len(behind.Commits))
+	return drift
+}
+
+// deployedSHA returns the sha of the last deployment of a service environment
+func deployedSHA(git *gitlabClient.Client, service *contours.ServiceInfo) (string, error) {
+	deployment, err := lastDeployment(git, service)
+	if err != nil {
+		return "", err
+	}
+	return deployment.SHA, nil
+}
+
+// lastDeployment of a service environment, it's an error if there is none
+func lastDeployment(git *gitlabClient.Client, service *contours.ServiceInfo) (*gitlab.Deployment, error) {
+	env, err := git.GetEnvironment(service.GetProject(), service.GetEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	if env.LastDeployment == nil {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("environment %d of the project %d has never been deployed", service.GetEnvironment(), service.GetProject()))
+	}
+	return env.LastDeployment, nil
+}
+
+// sameCommit tells if two shas point to the same commit, either of them may be abbreviated
+func sameCommit(a, b string) bool {
+	if len(a) < minSHALength || len(b) < minSHALength {
+		return a != "" && a == b
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return strings.HasPrefix(b, a)
+}
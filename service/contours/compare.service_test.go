@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSameCommit(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "equal full shas", a: "279484c09fbe69ededfced8c1bb6e6d24616b468", b: "279484c09fbe69ededfced8c1bb6e6d24616b468", want: true},
+		{name: "short and full sha", a: "279484c0", b: "279484c09fbe69ededfced8c1bb6e6d24616b468", want: true},
+		{name: "full and short sha", a: "279484c09fbe69ededfced8c1bb6e6d24616b468", b: "279484c", want: true},
+		{name: "different shas", a: "279484c09fbe69ededfced8c1bb6e6d24616b468", b: "0123456789abcdef0123456789abcdef01234567"},
+		{name: "different short shas", a: "279484c0", b: "279484c1"},
+		{name: "too short prefix", a: "2794", b: "279484c09fbe69ededfced8c1bb6e6d24616b468"},
+		{name: "too short but equal", a: "2794", b: "2794", want: true},
+		{name: "empty shas"},
+		{name: "one empty sha", a: "279484c0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameCommit(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameCommit(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitlabPair(t *testing.T) {
+	urls := map[string]string{
+		"a":     "https://gitlab.example.com",
+		"b":     "https://GITLAB.example.com",
+		"other": "https://gitlab.other.com",
+	}
+	defer func(init func(context.Context, string) (*gitlabClient.Client, error)) { initGitlab = init }(initGitlab)
+	initGitlab = func(_ context.Context, contourID string) (*gitlabClient.Client, error) {
+		return gitlabClient.NewClient(&gitlabClient.Connection{URL: urls[contourID], Token: "token"})
+	}
+	providers := func(contourID string, types map[string]string) *serviceProviders {
+		return &serviceProviders{contourID: contourID, types: types}
+	}
+	service := &contours.ServiceInfo{Id: "service", Project: 1}
+	tests := []struct {
+		name   string
+		base   *serviceProviders
+		target *serviceProviders
+		code   codes.Code
+	}{
+		{name: "same instance", base: providers("a", nil), target: providers("b", nil)},
+		{name: "other instance", base: providers("a", nil), target: providers("other", nil), code: codes.FailedPrecondition},
+		{name: "github service", base: providers("a", nil), target: providers("b", map[string]string{"service": scm.ProviderGithub}), code: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := gitlabPair(context.Background(), tt.base, service, tt.target, service)
+			if status.Code(err) != tt.code {
+				t.Errorf("gitlabPair() error = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
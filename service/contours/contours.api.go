@@ -164,3 +164,212 @@ func (s *contoursGrpcServer) RemoveService(ctx context.Context, in *contours.Ser
 
 	return RemoveService(ctx, in)
 }
+
+func (s *contoursGrpcServer) GetStatus(ctx context.Context, in *contours.ContourId) (*contours.ContourStatus, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return GetStatus(ctx, in)
+}
+
+func (s *contoursGrpcServer) Compare(ctx context.Context, in *contours.ContoursToCompare) (*contours.ContoursDrift, error) {
+	logger.EnpointHit(ctx)
+	for _, id := range []string{in.GetBaseContourId(), in.GetTargetContourId()} {
+		if err := checkContourRight(ctx, id, rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+			return nil, err
+		}
+	}
+	return Compare(ctx, in)
+}
+
+func (s *contoursGrpcServer) Promote(ctx context.Context, in *contours.ContoursToPromote) (*contours.PromoteReport, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetSourceContourId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	if err := checkContourRight(ctx, in.GetTargetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return Promote(ctx, in)
+}
+
+func (s *contoursGrpcServer) ImportFromGitlabGroup(ctx context.Context, in *contours.GitlabGroupImport) (*contours.ImportReport, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return ImportFromGitlabGroup(ctx, in)
+}
+
+func (s *contoursGrpcServer) StopEnvironments(in *contours.ContourId, stream contours.Contours_StopEnvironmentsServer) error {
+	logger.EnpointHit(stream.Context())
+	if err := checkContourRight(stream.Context(), in.GetId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return err
+	}
+	return StopEnvironments(stream.Context(), in, stream.Send)
+}
+
+func (s *contoursGrpcServer) StartEnvironments(in *contours.ContourId, stream contours.Contours_StartEnvironmentsServer) error {
+	logger.EnpointHit(stream.Context())
+	if err := checkContourRight(stream.Context(), in.GetId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return err
+	}
+	return StartEnvironments(stream.Context(), in, stream.Send)
+}
+
+func (s *contoursGrpcServer) ListDeployments(in *contours.DeploymentsListOptions, stream contours.Contours_ListDeploymentsServer) error {
+	logger.EnpointHit(stream.Context())
+	if err := checkContourRight(stream.Context(), in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return err
+	}
+	return ListDeployments(stream.Context(), in, stream.Send)
+}
+
+func (s *contoursGrpcServer) RefreshContour(ctx context.Context, in *contours.ContourId) (*contours.ContourStatus, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return RefreshContour(ctx, in)
+}
+
+func (s *contoursGrpcServer) PendingChanges(ctx context.Context, in *contours.ContourId) (*contours.ContourPendingChanges, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return PendingChanges(ctx, in)
+}
+
+func (s *contoursGrpcServer) GetHealth(ctx context.Context, in *contours.ContourId) (*contours.ContourHealth, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return GetHealth(ctx, in)
+}
+
+func (s *contoursGrpcServer) PinServices(ctx context.Context, in *contours.ServicePins) (*common.EmptyMessage, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return PinServices(ctx, in)
+}
+
+func (s *contoursGrpcServer) CheckDrift(ctx context.Context, in *contours.ContourId) (*contours.ContourPinsDrift, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return CheckDrift(ctx, in)
+}
+
+func (s *contoursGrpcServer) RollbackService(ctx context.Context, in *contours.ServiceIdAndContourId) (*contours.ServiceRollback, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return RollbackService(ctx, in)
+}
+
+func (s *contoursGrpcServer) SetVariables(ctx context.Context, in *contours.ContourVariables) (*common.EmptyMessage, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return SetVariables(ctx, in)
+}
+
+func (s *contoursGrpcServer) RemoveVariables(ctx context.Context, in *contours.VariableKeys) (*common.EmptyMessage, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return RemoveVariables(ctx, in)
+}
+
+func (s *contoursGrpcServer) ListVariables(ctx context.Context, in *contours.ContourId) (*contours.ContourVariables, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return ListVariables(ctx, in)
+}
+
+func (s *contoursGrpcServer) SyncVariables(ctx context.Context, in *contours.VariablesSync) (*contours.VariablesSyncReport, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return SyncVariables(ctx, in)
+}
+
+func (s *contoursGrpcServer) LinkMergeRequest(ctx context.Context, in *contours.MergeRequestToLink) (*contours.LinkedMergeRequest, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetContourId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return nil, err
+	}
+	return LinkMergeRequest(ctx, in)
+}
+
+func (s *contoursGrpcServer) ListMergeRequests(ctx context.Context, in *contours.ContourId) (*contours.ContourMergeRequests, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return ListMergeRequests(ctx, in)
+}
+
+func (s *contoursGrpcServer) Staleness(ctx context.Context, in *contours.ContourId) (*contours.ContourStaleness, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return Staleness(ctx, in)
+}
+
+func (s *contoursGrpcServer) ReleaseNotes(ctx context.Context, in *contours.ReleaseNotesOptions) (*contours.ReleaseNotesDocument, error) {
+	logger.EnpointHit(ctx)
+	if err := checkContourRight(ctx, in.GetFromContourId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	if err := checkContourRight(ctx, in.GetToContourId(), rights.AccessRights_ACCESS_RIGHTS_READ_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+	return ReleaseNotes(ctx, in)
+}
+
+func (s *contoursGrpcServer) Lock(in *contours.ContourId, stream contours.Contours_LockServer) error {
+	logger.EnpointHit(stream.Context())
+	if err := checkContourRight(stream.Context(), in.GetId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return err
+	}
+	return Lock(stream.Context(), in, stream.Send)
+}
+
+func (s *contoursGrpcServer) Unlock(in *contours.ContourId, stream contours.Contours_UnlockServer) error {
+	logger.EnpointHit(stream.Context())
+	if err := checkContourRight(stream.Context(), in.GetId(), rights.AccessRights_ACCESS_RIGHTS_WRITE); err != nil {
+		return err
+	}
+	return Unlock(stream.Context(), in, stream.Send)
+}
+
+// checkContourRight checks that the caller has the right on the application owning the contour
+func checkContourRight(ctx context.Context, contourID string, right rights.AccessRights) error {
+	_, err := grpcusers.AuthorizationClient.ValidateToken(ctx, &common.EmptyMessage{})
+	if err != nil {
+		return err
+	}
+	appID, err := GetAppIDByContourID(ctx, contourID)
+	if err != nil {
+		return err
+	}
+	_, err = grpcusers.RightsClient.CheckRight(ctx, &rights.AccessRightRequest{
+		ApplicationId: appID,
+		AccessRight:   right,
+	})
+	return err
+}
@@ -2,10 +2,16 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	repo "github.com/badhouseplants/envspotting-apps/repo/contours"
+	deploymentsRepo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	projectsRepo "github.com/badhouseplants/envspotting-apps/repo/projects"
+	appsService "github.com/badhouseplants/envspotting-apps/service/applications"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
 	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
 	"github.com/badhouseplants/envspotting-apps/tools/logger"
 	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
 	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
@@ -23,13 +29,29 @@ var initRepo = func(ctx context.Context) repo.ContourStore {
 	if apprepo == nil {
 		log.Info("Init new repo")
 		apprepo = repo.ContourRepo{
-			Pool:      postgres.Pool(ctx),
+			Pool:      postgres.Pool,
 			CreatedAt: time.Now(),
 		}
 	}
 	return apprepo
 }
 
+var initStateRepo = func(ctx context.Context) deploymentsRepo.DeploymentStateStore {
+	return deploymentsRepo.DeploymentStateRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// initGitlab with the connection of the application owning the contour
+var initGitlab = func(ctx context.Context, contourID string) (*gitlabClient.Client, error) {
+	appID, err := GetAppIDByContourID(ctx, contourID)
+	if err != nil {
+		return nil, err
+	}
+	return appsService.GitlabClient(ctx, appID)
+}
+
 // Create a new contour
 func Create(ctx context.Context, in *contours.ContourNameAndDescription) (*contours.ContourInfoWithoutServices, error) {
 	repo := initRepo(ctx)
@@ -47,13 +69,16 @@ func Create(ctx context.Context, in *contours.ContourNameAndDescription) (*conto
 	return contour, nil
 }
 
-// Get a contour
+// Get a contour with its health
 func Get(ctx context.Context, in *contours.ContourId) (*contours.ContourInfo, error) {
 	repo := initRepo(ctx)
 	app, err := repo.Get(ctx, in)
 	if err != nil {
 		return nil, err
 	}
+	if err := withHealth(ctx, app); err != nil {
+		return nil, err
+	}
 	return app, nil
 }
 
@@ -67,16 +92,29 @@ func Update(ctx context.Context, contour *contours.ContourInfoWithoutServices) (
 	return contour, nil
 }
 
-// List contours
+// List contours with their health
 func List(ctx context.Context, stream contours.Contours_ListServer, options *contours.ContoursListOption) error {
 	repo := initRepo(ctx)
-	err := repo.List(ctx, stream, options)
+	err := repo.List(ctx, healthStream{Contours_ListServer: stream, ctx: ctx}, options)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// healthStream sets the health of every contour the repo sends
+type healthStream struct {
+	contours.Contours_ListServer
+	ctx context.Context
+}
+
+func (s healthStream) Send(contour *contours.ContourInfo) error {
+	if err := withHealth(s.ctx, contour); err != nil {
+		return err
+	}
+	return s.Contours_ListServer.Send(contour)
+}
+
 // Delete a contour
 func Delete(ctx context.Context, in *contours.ContourIdAndName) (out *common.EmptyMessage, err error) {
 	repo := initRepo(ctx)
@@ -88,7 +126,14 @@ func Delete(ctx context.Context, in *contours.ContourIdAndName) (out *common.Emp
 		return nil, err
 	}
 	if appGotten.Name == in.Name {
-		err := repo.Delete(ctx, in)
+		locked, err := initLocksRepo(ctx).Locked(ctx, in.GetId())
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("contour %s is locked, unlock it first", in.GetId()))
+		}
+		err = repo.Delete(ctx, in)
 		if err != nil {
 			return nil, err
 		}
@@ -98,34 +143,69 @@ func Delete(ctx context.Context, in *contours.ContourIdAndName) (out *common.Emp
 	return &common.EmptyMessage{}, nil
 }
 
-// AddServices to a contour
+// AddServices hosted by one provider to a contour
 func AddServices(ctx context.Context, in *contours.RepeatedServiceWithoutId) (*common.EmptyMessage, error) {
+	providerType, err := scm.ValidateType(in.GetProvider())
+	if err != nil {
+		return nil, err
+	}
 	repo := initRepo(ctx)
+	providers, err := initProviders(ctx, in.GetContourId())
+	if err != nil {
+		return nil, err
+	}
+	provider, err := providers.forType(ctx, providerType)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := validateServices(ctx, provider, in.GetServices(), in.GetCreateMissingEnvironments())
+	if err != nil {
+		return nil, err
+	}
 	servicesWithID := &contours.RepeatedServiceWithId{
 		ContourId: in.GetContourId(),
 	}
-	for _, service := range in.Services {
+	serviceProviders := map[string]string{}
+	serviceProjects := make([]*projectsRepo.ServiceProject, 0, len(in.Services))
+	for i, service := range in.Services {
 		serviceInfo := &contours.ServiceInfo{
-			Id:          uuid.NewString(),
-			Project:     service.Project,
-			Environment: service.Environment,
+			Id:              uuid.NewString(),
+			Project:         service.Project,
+			Environment:     service.Environment,
+			EnvironmentName: service.EnvironmentName,
 		}
 		servicesWithID.Services = append(servicesWithID.Services, serviceInfo)
+		serviceProviders[serviceInfo.Id] = providerType
+		serviceProjects = append(serviceProjects, &projectsRepo.ServiceProject{
+			ContourID: in.GetContourId(),
+			ServiceID: serviceInfo.Id,
+			Project:   projects[i].ID,
+			Path:      projects[i].Path,
+		})
 	}
-	err := repo.AddServices(ctx, servicesWithID)
+	err = repo.AddServices(ctx, servicesWithID, serviceProviders, serviceProjects)
 	if err != nil {
 		return nil, err
 	}
+	updateMergeRequestNotes(ctx, in.GetContourId())
 	return &common.EmptyMessage{}, nil
 }
 
-// RemoveService from contour
+// RemoveService from contour, locked services must be unlocked first
+// so protection of their environments is restored
 func RemoveService(ctx context.Context, in *contours.ServiceIdAndContourId) (*common.EmptyMessage, error) {
+	_, err := initLocksRepo(ctx).Get(ctx, in.GetContourId(), in.GetServiceId())
+	if err == nil {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("service %s of the contour %s is locked, unlock the contour first", in.GetServiceId(), in.GetContourId()))
+	} else if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
 	repo := initRepo(ctx)
-	err := repo.RemoveService(ctx, in)
+	err = repo.RemoveService(ctx, in)
 	if err != nil {
 		return nil, err
 	}
+	updateMergeRequestNotes(ctx, in.GetContourId())
 	return &common.EmptyMessage{}, nil
 }
 
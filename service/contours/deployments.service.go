@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeploymentSender streams deployments back to the caller
+type DeploymentSender func(*contours.DeploymentInfo) error
+
+// ListDeployments of contour services newest first.
+// Services are paged through lazily and merged by their update time
+func ListDeployments(ctx context.Context, opts *contours.DeploymentsListOptions, send DeploymentSender) error {
+	contour, err := initRepo(ctx).Get(ctx, &contours.ContourId{Id: opts.GetContourId()})
+	if err != nil {
+		return err
+	}
+	providers, err := initProviders(ctx, opts.GetContourId())
+	if err != nil {
+		return err
+	}
+	var pagers []*deploymentPager
+	for _, service := range contour.GetServices() {
+		if opts.GetServiceId() != "" && service.GetId() != opts.GetServiceId() {
+			continue
+		}
+		provider, err := providers.forService(ctx, service.GetId())
+		if err != nil {
+			return err
+		}
+		env, err := provider.GetEnvironment(ctx, service.GetProject(), service.GetEnvironment())
+		if err != nil {
+			return err
+		}
+		pagers = append(pagers, newDeploymentPager(provider, service, env.Name, opts))
+	}
+	if opts.GetServiceId() != "" && len(pagers) == 0 {
+		return status.Error(codes.NotFound, fmt.Sprintf("service %s can't be found in the contour %s", opts.GetServiceId(), opts.GetContourId()))
+	}
+	statuses := make(map[string]bool, len(opts.GetStatuses()))
+	for _, s := range opts.GetStatuses() {
+		statuses[s] = true
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		newest, err := newestPager(ctx, pagers)
+		if err != nil {
+			return err
+		}
+		if newest == nil {
+			return nil
+		}
+		deployment := newest.pop()
+		if len(statuses) > 0 && !statuses[deployment.Status] {
+			continue
+		}
+		if err := send(newest.info(deployment)); err != nil {
+			return err
+		}
+	}
+}
+
+// newestPager returns the pager whose next deployment is the newest one, or nil if all are drained
+func newestPager(ctx context.Context, pagers []*deploymentPager) (*deploymentPager, error) {
+	var newest *deploymentPager
+	for _, pager := range pagers {
+		next, err := pager.peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
+			continue
+		}
+		if newest == nil || deploymentTime(next).After(deploymentTime(newest.buffer[0])) {
+			newest = pager
+		}
+	}
+	return newest, nil
+}
+
+func deploymentTime(deployment *scm.Deployment) time.Time {
+	if deployment.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *deployment.UpdatedAt
+}
+
+// deploymentPager reads deployments of one service environment page by page
+type deploymentPager struct {
+	provider scm.Provider
+	service  *contours.ServiceInfo
+	envName  string
+	query    *scm.DeploymentsQuery
+	buffer   []*scm.Deployment
+	done     bool
+}
+
+func newDeploymentPager(provider scm.Provider, service *contours.ServiceInfo, envName string, opts *contours.DeploymentsListOptions) *deploymentPager {
+	query := &scm.DeploymentsQuery{
+		Environment: envName,
+		Since:       optionalTime(opts.GetSince()),
+		Until:       optionalTime(opts.GetUntil()),
+		Page:        1,
+		PerPage:     50,
+	}
+	// Providers filter by a single status only, others are filtered here
+	if len(opts.GetStatuses()) == 1 {
+		query.Status = opts.GetStatuses()[0]
+	}
+	return &deploymentPager{
+		provider: provider,
+		service:  service,
+		envName:  envName,
+		query:    query,
+	}
+}
+
+// peek at the next deployment fetching new pages if needed.
+// Pages filtered by the provider may be empty while there are more of them
+func (p *deploymentPager) peek(ctx context.Context) (*scm.Deployment, error) {
+	for len(p.buffer) == 0 && !p.done {
+		deployments, next, err := p.provider.ListDeployments(ctx, p.service.GetProject(), p.query)
+		if err != nil {
+			return nil, err
+		}
+		p.buffer = deployments
+		p.done = next == 0
+		p.query.Page = next
+	}
+	if len(p.buffer) == 0 {
+		return nil, nil
+	}
+	return p.buffer[0], nil
+}
+
+func (p *deploymentPager) pop() *scm.Deployment {
+	deployment := p.buffer[0]
+	p.buffer = p.buffer[1:]
+	return deployment
+}
+
+func (p *deploymentPager) info(deployment *scm.Deployment) *contours.DeploymentInfo {
+	return &contours.DeploymentInfo{
+		ServiceId:       p.service.GetId(),
+		Project:         p.service.GetProject(),
+		EnvironmentName: p.envName,
+		Id:              deployment.ID,
+		Ref:             deployment.Ref,
+		Sha:             deployment.SHA,
+		Status:          deployment.Status,
+		Deployer:        deployment.Deployer,
+		CommitTitle:     deployment.CommitTitle,
+		Author:          deployment.Author,
+		UpdatedAt:       timestamp(deployment.UpdatedAt),
+	}
+}
+
+// optionalTime converts an optional timestamp, nil stays nil
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+)
+
+// pagedProvider serves deployments of every project from fixed pages, page numbers start at 1
+type pagedProvider struct {
+	scm.Provider
+	pages map[int64][][]*scm.Deployment
+}
+
+func (p *pagedProvider) ListDeployments(_ context.Context, project int64, query *scm.DeploymentsQuery) ([]*scm.Deployment, int, error) {
+	pages := p.pages[project]
+	if query.Page > len(pages) {
+		return nil, 0, nil
+	}
+	next := query.Page + 1
+	if next > len(pages) {
+		next = 0
+	}
+	return pages[query.Page-1], next, nil
+}
+
+func deploymentAt(id int64, hour int) *scm.Deployment {
+	t := time.Date(2021, 8, 1, hour, 0, 0, 0, time.UTC)
+	return &scm.Deployment{ID: id, UpdatedAt: &t}
+}
+
+func TestNewestPagerMerge(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages map[int64][][]*scm.Deployment
+		want  []int64
+	}{
+		{
+			name: "one service",
+			pages: map[int64][][]*scm.Deployment{
+				1: {{deploymentAt(1, 3), deploymentAt(2, 2)}, {deploymentAt(3, 1)}},
+			},
+			want: []int64{1, 2, 3},
+		},
+		{
+			name: "services merged newest first",
+			pages: map[int64][][]*scm.Deployment{
+				1: {{deploymentAt(1, 5), deploymentAt(2, 2)}},
+				2: {{deploymentAt(3, 4)}, {deploymentAt(4, 3), deploymentAt(5, 1)}},
+			},
+			want: []int64{1, 3, 4, 2, 5},
+		},
+		{
+			name: "empty pages in the middle",
+			pages: map[int64][][]*scm.Deployment{
+				1: {{deploymentAt(1, 5)}, {}, {}, {deploymentAt(2, 1)}},
+				2: {{deploymentAt(3, 3)}},
+			},
+			want: []int64{1, 3, 2},
+		},
+		{
+			name: "empty first page",
+			pages: map[int64][][]*scm.Deployment{
+				1: {{}, {deploymentAt(1, 2)}},
+			},
+			want: []int64{1},
+		},
+		{
+			name: "no deployments",
+			pages: map[int64][][]*scm.Deployment{
+				1: {{}},
+				2: {},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &pagedProvider{pages: tt.pages}
+			var pagers []*deploymentPager
+			for project := range tt.pages {
+				service := &contours.ServiceInfo{Project: project}
+				pagers = append(pagers, newDeploymentPager(provider, service, "staging", &contours.DeploymentsListOptions{}))
+			}
+			var got []int64
+			for {
+				newest, err := newestPager(context.Background(), pagers)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if newest == nil {
+					break
+				}
+				got = append(got, newest.pop().ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("deployments = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("deployments = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+)
+
+// ProgressSender streams progress back to the caller
+type ProgressSender func(*contours.EnvironmentProgress) error
+
+// StopEnvironments of every contour service
+func StopEnvironments(ctx context.Context, in *contours.ContourId, send ProgressSender) error {
+	return forEachEnvironment(ctx, in, contours.EnvironmentAction_ENVIRONMENT_ACTION_STOP, send, func(git *gitlabClient.Client, service *contours.ServiceInfo, progress *contours.EnvironmentProgress) error {
+		return git.StopEnvironment(service.GetProject(), service.GetEnvironment())
+	})
+}
+
+// StartEnvironments of every contour service by retrying their last successful deploy jobs
+func StartEnvironments(ctx context.Context, in *contours.ContourId, send ProgressSender) error {
+	return forEachEnvironment(ctx, in, contours.EnvironmentAction_ENVIRONMENT_ACTION_START, send, func(git *gitlabClient.Client, service *contours.ServiceInfo, progress *contours.EnvironmentProgress) error {
+		env, err := git.GetEnvironment(service.GetProject(), service.GetEnvironment())
+		if err != nil {
+			return err
+		}
+		deployment, err := git.LastSuccessfulDeployment(service.GetProject(), env.Name)
+		if err != nil {
+			return err
+		}
+		job, err := git.RetryJob(service.GetProject(), deployment.Deployable.ID)
+		if err != nil {
+			return err
+		}
+		progress.JobId = int64(job.ID)
+		return nil
+	})
+}
+
+// forEachEnvironment runs the action on every gitlab service and sends its progress,
+// failed services don't stop the others
+func forEachEnvironment(
+	ctx context.Context,
+	in *contours.ContourId,
+	action contours.EnvironmentAction,
+	send ProgressSender,
+	run func(*gitlabClient.Client, *contours.ServiceInfo, *contours.EnvironmentProgress) error,
+) error {
+	contour, err := initRepo(ctx).Get(ctx, in)
+	if err != nil {
+		return err
+	}
+	providers, err := initProviders(ctx, in.GetId())
+	if err != nil {
+		return err
+	}
+	for _, service := range contour.GetServices() {
+		progress := &contours.EnvironmentProgress{
+			ServiceId:   service.GetId(),
+			Project:     service.GetProject(),
+			Environment: service.GetEnvironment(),
+			Action:      action,
+		}
+		if git, err := providers.gitlab(ctx, service.GetId()); err != nil {
+			progress.Error = err.Error()
+		} else if err := run(git, service, progress); err != nil {
+			progress.Error = err.Error()
+		}
+		if err := send(progress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
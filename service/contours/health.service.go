@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	deploymentsRepo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/spf13/viper"
+)
+
+// healthWeight orders health states from the least to the most important one
+var healthWeight = map[contours.Health]int{
+	contours.Health_HEALTH_HEALTHY:             0,
+	contours.Health_HEALTH_UNKNOWN_UNSPECIFIED: 1,
+	contours.Health_HEALTH_DEPLOYING:           2,
+	contours.Health_HEALTH_FAILED:              3,
+}
+
+// GetHealth of a contour computed from stored deployment states
+func GetHealth(ctx context.Context, in *contours.ContourId) (*contours.ContourHealth, error) {
+	contour, err := initRepo(ctx).Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return contourHealth(ctx, contour)
+}
+
+// withHealth sets the health of a contour
+func withHealth(ctx context.Context, contour *contours.ContourInfo) error {
+	health, err := contourHealth(ctx, contour)
+	if err != nil {
+		return err
+	}
+	contour.Health = health.GetHealth()
+	return nil
+}
+
+func contourHealth(ctx context.Context, contour *contours.ContourInfo) (*contours.ContourHealth, error) {
+	states, err := initStateRepo(ctx).ListByContour(ctx, contour.GetId())
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*deploymentsRepo.DeploymentState, len(states))
+	for _, state := range states {
+		stored[state.ServiceID] = state
+	}
+	staleSince := time.Now().Add(-viper.GetDuration("status_stale_after"))
+	return healthOf(contour, stored, staleSince), nil
+}
+
+// healthOf a contour is the worst health of its services.
+// States nothing has updated since staleSince may be outdated, their health is unknown
+func healthOf(contour *contours.ContourInfo, stored map[string]*deploymentsRepo.DeploymentState, staleSince time.Time) *contours.ContourHealth {
+	health := &contours.ContourHealth{ContourId: contour.GetId()}
+	for i, service := range contour.GetServices() {
+		serviceHealth := &contours.ServiceHealth{ServiceId: service.GetId()}
+		if state, ok := stored[service.GetId()]; ok {
+			serviceHealth.DeploymentStatus = state.Status
+			serviceHealth.PipelineStatus = state.PipelineStatus
+			if !state.UpdatedAt.Before(staleSince) {
+				serviceHealth.Health = stateHealth(state)
+			}
+		}
+		if i == 0 || healthWeight[serviceHealth.Health] > healthWeight[health.Health] {
+			health.Health = serviceHealth.Health
+		}
+		health.Services = append(health.Services, serviceHealth)
+	}
+	return health
+}
+
+// stateHealth of a service from its last deployment and pipeline statuses
+func stateHealth(state *deploymentsRepo.DeploymentState) contours.Health {
+	switch state.PipelineStatus {
+	case "failed":
+		return contours.Health_HEALTH_FAILED
+	case "created", "waiting_for_resource", "preparing", "pending", "running":
+		return contours.Health_HEALTH_DEPLOYING
+	}
+	switch state.Status {
+	case "failed", "canceled":
+		return contours.Health_HEALTH_FAILED
+	case "created", "running":
+		return contours.Health_HEALTH_DEPLOYING
+	case "success":
+		return contours.Health_HEALTH_HEALTHY
+	default:
+		return contours.Health_HEALTH_UNKNOWN_UNSPECIFIED
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	deploymentsRepo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+)
+
+func TestStateHealth(t *testing.T) {
+	tests := []struct {
+		status         string
+		pipelineStatus string
+		want           contours.Health
+	}{
+		{status: "success", want: contours.Health_HEALTH_HEALTHY},
+		{status: "success", pipelineStatus: "success", want: contours.Health_HEALTH_HEALTHY},
+		{status: "success", pipelineStatus: "failed", want: contours.Health_HEALTH_FAILED},
+		{status: "success", pipelineStatus: "running", want: contours.Health_HEALTH_DEPLOYING},
+		{status: "running", want: contours.Health_HEALTH_DEPLOYING},
+		{status: "failed", want: contours.Health_HEALTH_FAILED},
+		{status: "canceled", want: contours.Health_HEALTH_FAILED},
+		{want: contours.Health_HEALTH_UNKNOWN_UNSPECIFIED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+tt.pipelineStatus, func(t *testing.T) {
+			state := &deploymentsRepo.DeploymentState{Status: tt.status, PipelineStatus: tt.pipelineStatus}
+			if got := stateHealth(state); got != tt.want {
+				t.Errorf("stateHealth() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthOf(t *testing.T) {
+	now := time.Now()
+	state := func(status string) *deploymentsRepo.DeploymentState {
+		return &deploymentsRepo.DeploymentState{Status: status, UpdatedAt: now}
+	}
+	stale := &deploymentsRepo.DeploymentState{Status: "failed", UpdatedAt: now.Add(-time.Hour)}
+	tests := []struct {
+		name   string
+		stored map[string]*deploymentsRepo.DeploymentState
+		want   contours.Health
+	}{
+		{
+			name:   "all healthy",
+			stored: map[string]*deploymentsRepo.DeploymentState{"a": state("success"), "b": state("success")},
+			want:   contours.Health_HEALTH_HEALTHY,
+		},
+		{
+			name:   "unknown service",
+			stored: map[string]*deploymentsRepo.DeploymentState{"a": state("success")},
+			want:   contours.Health_HEALTH_UNKNOWN_UNSPECIFIED,
+		},
+		{
+			name:   "deploying wins over unknown",
+			stored: map[string]*deploymentsRepo.DeploymentState{"a": state("running")},
+			want:   contours.Health_HEALTH_DEPLOYING,
+		},
+		{
+			name:   "failed wins",
+			stored: map[string]*deploymentsRepo.DeploymentState{"a": state("failed"), "b": state("running")},
+			want:   contours.Health_HEALTH_FAILED,
+		},
+		{
+			name:   "stale state is unknown",
+			stored: map[string]*deploymentsRepo.DeploymentState{"a": stale, "b": state("success")},
+			want:   contours.Health_HEALTH_UNKNOWN_UNSPECIFIED,
+		},
+	}
+	contour := &contours.ContourInfo{Id: "contour", Services: []*contours.ServiceInfo{{Id: "a"}, {Id: "b"}}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := healthOf(contour, tt.stored, now.Add(-10*time.Minute))
+			if health.GetHealth() != tt.want {
+				t.Errorf("health = %s, want %s", health.GetHealth(), tt.want)
+			}
+			if len(health.GetServices()) != 2 {
+				t.Errorf("services = %d, want 2", len(health.GetServices()))
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImportFromGitlabGroup adds every project of a group that has a matching environment to the contour
+func ImportFromGitlabGroup(ctx context.Context, opts *contours.GitlabGroupImport) (*contours.ImportReport, error) {
+	if _, err := path.Match(opts.GetEnvironment(), ""); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("environment pattern is invalid: %v", err))
+	}
+	include, err := compileOptionalRegexp(opts.GetInclude())
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := compileOptionalRegexp(opts.GetExclude())
+	if err != nil {
+		return nil, err
+	}
+	contour, err := initRepo(ctx).Get(ctx, &contours.ContourId{Id: opts.GetContourId()})
+	if err != nil {
+		return nil, err
+	}
+	git, err := initGitlab(ctx, opts.GetContourId())
+	if err != nil {
+		return nil, err
+	}
+	projects, err := git.ListGroupProjects(opts.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+	existing := map[[2]int64]bool{}
+	for _, service := range contour.GetServices() {
+		existing[[2]int64{service.GetProject(), service.GetEnvironment()}] = true
+	}
+	var (
+		report   = &contours.ImportReport{}
+		toImport []*contours.ServiceWithoutId
+	)
+	for _, project := range projects {
+		if reason := filteredOut(project.PathWithNamespace, include, exclude); reason != "" {
+			report.Skipped = append(report.Skipped, &contours.ImportedService{
+				Project:     int64(project.ID),
+				ProjectPath: project.PathWithNamespace,
+				SkipReason:  reason,
+			})
+			continue
+		}
+		services, err := matchEnvironments(git, project, opts.GetEnvironment())
+		if err != nil {
+			return nil, err
+		}
+		if len(services) == 0 {
+			report.Skipped = append(report.Skipped, &contours.ImportedService{
+				Project:     int64(project.ID),
+				ProjectPath: project.PathWithNamespace,
+				SkipReason:  "no matching environment",
+			})
+			continue
+		}
+		for _, service := range services {
+			key := [2]int64{service.GetProject(), service.GetEnvironment()}
+			if existing[key] {
+				service.SkipReason = "already in the contour"
+				report.Skipped = append(report.Skipped, service)
+				continue
+			}
+			existing[key] = true
+			report.Added = append(report.Added, service)
+			toImport = append(toImport, &contours.ServiceWithoutId{
+				Project:         service.GetProject(),
+				Environment:     service.GetEnvironment(),
+				EnvironmentName: service.GetEnvironmentName(),
+			})
+		}
+	}
+	if len(toImport) == 0 {
+		return report, nil
+	}
+	_, err = AddServices(ctx, &contours.RepeatedServiceWithoutId{
+		ContourId: opts.GetContourId(),
+		AppId:     opts.GetAppId(),
+		Services:  toImport,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// matchEnvironments of a project against the name pattern
+func matchEnvironments(git *gitlabClient.Client, project *gitlab.Project, pattern string) ([]*contours.ImportedService, error) {
+	envs, err := git.ListEnvironments(int64(project.ID))
+	if err != nil {
+		return nil, err
+	}
+	var services []*contours.ImportedService
+	for _, env := range envs {
+		// The pattern has already been validated, so errors can't happen here
+		if ok, _ := path.Match(pattern, env.Name); ok {
+			services = append(services, &contours.ImportedService{
+				Project:         int64(project.ID),
+				ProjectPath:     project.PathWithNamespace,
+				Environment:     int64(env.ID),
+				EnvironmentName: env.Name,
+			})
+		}
+	}
+	return services, nil
+}
+
+// filteredOut returns why the project path is filtered out by the regexps, empty if it isn't
+func filteredOut(projectPath string, include, exclude *regexp.Regexp) string {
+	if include != nil && !include.MatchString(projectPath) {
+		return fmt.Sprintf("doesn't match the include regexp %s", include)
+	}
+	if exclude != nil && exclude.MatchString(projectPath) {
+		return fmt.Sprintf("matches the exclude regexp %s", exclude)
+	}
+	return ""
+}
+
+func compileOptionalRegexp(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("regexp is invalid: %v", err))
+	}
+	return re, nil
+}
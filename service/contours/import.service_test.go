@@ -0,0 +1,36 @@
+package service
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestFilteredOut(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		include string
+		exclude string
+		want    string
+	}{
+		{name: "no regexps", path: "group/api"},
+		{name: "included", path: "group/api", include: "^group/"},
+		{name: "not included", path: "other/api", include: "^group/", want: "doesn't match the include regexp ^group/"},
+		{name: "excluded", path: "group/api-tests", exclude: "-tests$", want: "matches the exclude regexp -tests$"},
+		{name: "included but excluded", path: "group/api-tests", include: "^group/", exclude: "-tests$", want: "matches the exclude regexp -tests$"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var include, exclude *regexp.Regexp
+			if tt.include != "" {
+				include = regexp.MustCompile(tt.include)
+			}
+			if tt.exclude != "" {
+				exclude = regexp.MustCompile(tt.exclude)
+			}
+			if got := filteredOut(tt.path, include, exclude); got != tt.want {
+				t.Errorf("filteredOut() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	locksRepo "github.com/badhouseplants/envspotting-apps/repo/locks"
+	appsService "github.com/badhouseplants/envspotting-apps/service/applications"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var initLocksRepo = func(ctx context.Context) locksRepo.LockStore {
+	return locksRepo.LockRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// Lock every contour service environment, so only the lock group of the gitlab
+// connection of the application can deploy to it until the contour is unlocked
+func Lock(ctx context.Context, in *contours.ContourId, send ProgressSender) error {
+	appID, err := GetAppIDByContourID(ctx, in.GetId())
+	if err != nil {
+		return err
+	}
+	groupID, err := appsService.LockGroupID(ctx, appID)
+	if err != nil {
+		return err
+	}
+	access := []*gitlab.EnvironmentAccessOptions{{GroupID: gitlab.Int(int(groupID))}}
+	return forEachEnvironment(ctx, in, contours.EnvironmentAction_ENVIRONMENT_ACTION_LOCK, send, func(git *gitlabClient.Client, service *contours.ServiceInfo, progress *contours.EnvironmentProgress) error {
+		env, err := git.GetEnvironment(service.GetProject(), service.GetEnvironment())
+		if err != nil {
+			return err
+		}
+		previous, err := git.GetProtectedEnvironment(service.GetProject(), env.Name)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		// Settings are stored before they're touched, so unlock can restore them whatever happens next
+		err = initLocksRepo(ctx).Add(ctx, &locksRepo.Lock{
+			ContourID:          in.GetId(),
+			ServiceID:          service.GetId(),
+			Project:            service.GetProject(),
+			Environment:        env.Name,
+			PreviousProtection: previous,
+		})
+		if err != nil {
+			return err
+		}
+		// Protection can't be changed in place, only dropped and created again
+		if previous != nil {
+			if err := git.UnprotectEnvironment(service.GetProject(), env.Name); err != nil {
+				return err
+			}
+		}
+		_, err = git.ProtectEnvironment(service.GetProject(), env.Name, access)
+		return err
+	})
+}
+
+// Unlock every contour service environment restoring the protection it had before the lock
+func Unlock(ctx context.Context, in *contours.ContourId, send ProgressSender) error {
+	return forEachEnvironment(ctx, in, contours.EnvironmentAction_ENVIRONMENT_ACTION_UNLOCK, send, func(git *gitlabClient.Client, service *contours.ServiceInfo, progress *contours.EnvironmentProgress) error {
+		lock, err := initLocksRepo(ctx).Get(ctx, in.GetId(), service.GetId())
+		if status.Code(err) == codes.NotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = git.UnprotectEnvironment(lock.Project, lock.Environment)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if lock.PreviousProtection != nil {
+			_, err = git.ProtectEnvironment(lock.Project, lock.Environment, accessOptions(lock.PreviousProtection))
+			if err != nil {
+				return err
+			}
+		}
+		return initLocksRepo(ctx).Remove(ctx, in.GetId(), service.GetId())
+	})
+}
+
+// accessOptions recreate the deploy access of a protected environment
+func accessOptions(protected *gitlab.ProtectedEnvironment) []*gitlab.EnvironmentAccessOptions {
+	access := make([]*gitlab.EnvironmentAccessOptions, 0, len(protected.DeployAccessLevels))
+	for _, level := range protected.DeployAccessLevels {
+		switch {
+		case level.UserID != 0:
+			access = append(access, &gitlab.EnvironmentAccessOptions{UserID: gitlab.Int(level.UserID)})
+		case level.GroupID != 0:
+			access = append(access, &gitlab.EnvironmentAccessOptions{GroupID: gitlab.Int(level.GroupID)})
+		default:
+			access = append(access, &gitlab.EnvironmentAccessOptions{AccessLevel: gitlab.AccessLevel(level.AccessLevel)})
+		}
+	}
+	return access
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	mergeRequestsRepo "github.com/badhouseplants/envspotting-apps/repo/mergerequests"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var initMergeRequestsRepo = func(ctx context.Context) mergeRequestsRepo.MergeRequestLinkStore {
+	return mergeRequestsRepo.MergeRequestLinkRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// mergeRequestNotesTimeout bounds comments on merge requests made in background
+const mergeRequestNotesTimeout = time.Minute
+
+// LinkMergeRequest to a contour and comment on it with services of the contour
+func LinkMergeRequest(ctx context.Context, in *contours.MergeRequestToLink) (*contours.LinkedMergeRequest, error) {
+	contourID, project, iid := in.GetContourId(), in.GetProject(), in.GetIid()
+	contour, err := initRepo(ctx).Get(ctx, &contours.ContourId{Id: contourID})
+	if err != nil {
+		return nil, err
+	}
+	git, err := initGitlab(ctx, contourID)
+	if err != nil {
+		return nil, err
+	}
+	mr, err := git.GetMergeRequest(project, iid)
+	if err != nil {
+		return nil, err
+	}
+	link := &mergeRequestsRepo.MergeRequestLink{
+		ContourID: contourID,
+		Project:   project,
+		IID:       iid,
+		Title:     mr.Title,
+		WebURL:    mr.WebURL,
+	}
+	if err := initMergeRequestsRepo(ctx).Add(ctx, link); err != nil {
+		return nil, err
+	}
+	if err := postContourNote(ctx, git, contour, link); err != nil {
+		return nil, err
+	}
+	return linkedMergeRequest(link), nil
+}
+
+// ListMergeRequests linked to a contour
+func ListMergeRequests(ctx context.Context, in *contours.ContourId) (*contours.ContourMergeRequests, error) {
+	links, err := initMergeRequestsRepo(ctx).List(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	mrs := &contours.ContourMergeRequests{}
+	for _, link := range links {
+		mrs.MergeRequests = append(mrs.MergeRequests, linkedMergeRequest(link))
+	}
+	return mrs, nil
+}
+
+// updateMergeRequestNotes in background after services of a contour changed,
+// so the change doesn't wait for gitlab and isn't canceled with the request
+func updateMergeRequestNotes(ctx context.Context, contourID string) {
+	background := ctxlogrus.ToContext(context.Background(), logger.GetGrpcLogger(ctx))
+	go func() {
+		ctx, cancel := context.WithTimeout(background, mergeRequestNotesTimeout)
+		defer cancel()
+		postMergeRequestNotes(ctx, contourID)
+	}()
+}
+
+// postMergeRequestNotes of all merge requests linked to a contour.
+// Comments are best effort, failures are only logged
+func postMergeRequestNotes(ctx context.Context, contourID string) {
+	log := logger.GetGrpcLogger(ctx)
+	links, err := initMergeRequestsRepo(ctx).List(ctx, contourID)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if len(links) == 0 {
+		return
+	}
+	contour, err := initRepo(ctx).Get(ctx, &contours.ContourId{Id: contourID})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	git, err := initGitlab(ctx, contourID)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, link := range links {
+		if err := postContourNote(ctx, git, contour, link); err != nil {
+			log.Errorf("can't comment on the merge request !%d of the project %d: %v", link.IID, link.Project, err)
+		}
+	}
+}
+
+// postContourNote creates the comment about the contour or updates the one posted before
+func postContourNote(ctx context.Context, git *gitlabClient.Client, contour *contours.ContourInfo, link *mergeRequestsRepo.MergeRequestLink) error {
+	body := contourNote(ctx, contour)
+	previous := link.NoteID
+	if link.NoteID != 0 {
+		_, err := git.UpdateMergeRequestNote(link.Project, link.IID, link.NoteID, body)
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+		// The comment was deleted by someone, so a new one is posted
+	}
+	note, err := git.CreateMergeRequestNote(link.Project, link.IID, body)
+	if err != nil {
+		return err
+	}
+	link.NoteID = int64(note.ID)
+	if err := initMergeRequestsRepo(ctx).SetNote(ctx, link, previous); err != nil {
+		return err
+	}
+	if link.NoteID != int64(note.ID) {
+		// A concurrent update has stored its comment first, so this one is a duplicate
+		return git.DeleteMergeRequestNote(link.Project, link.IID, int64(note.ID))
+	}
+	return nil
+}
+
+// contourNote renders services of the contour and their environment urls as markdown
+func contourNote(ctx context.Context, contour *contours.ContourInfo) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "This merge request can be tested in the contour **%s**\n\n", markdownCell(contour.GetName()))
+	if len(contour.GetServices()) == 0 {
+		b.WriteString("The contour has no services yet\n")
+		return b.String()
+	}
+	b.WriteString("| Project | Environment | URL |\n|---|---|---|\n")
+	providers, err := initProviders(ctx, contour.GetId())
+	if err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+	}
+	for _, service := range contour.GetServices() {
+		project, environment, url := describeService(ctx, providers, service)
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownCell(project), markdownCell(environment), markdownCell(url))
+	}
+	return b.String()
+}
+
+// describeService returns the project path, the environment name and url.
+// Ids are used for what can't be fetched
+func describeService(ctx context.Context, providers *serviceProviders, service *contours.ServiceInfo) (project, environment, url string) {
+	project, environment, url = fmt.Sprint(service.GetProject()), fmt.Sprint(service.GetEnvironment()), "-"
+	if providers == nil {
+		return
+	}
+	provider, err := providers.forService(ctx, service.GetId())
+	if err != nil {
+		return
+	}
+	if proj, err := provider.GetProject(ctx, service.GetProject()); err == nil {
+		project = proj.Path
+	}
+	if env, err := provider.GetEnvironment(ctx, service.GetProject(), service.GetEnvironment()); err == nil {
+		environment = env.Name
+		if env.ExternalURL != "" {
+			url = env.ExternalURL
+		}
+	}
+	return
+}
+
+// markdownCell escapes what would break a markdown table row
+func markdownCell(value string) string {
+	return markdownCellReplacer.Replace(value)
+}
+
+var markdownCellReplacer = strings.NewReplacer("|", "\\|", "\r", " ", "\n", " ")
+
+func linkedMergeRequest(link *mergeRequestsRepo.MergeRequestLink) *contours.LinkedMergeRequest {
+	return &contours.LinkedMergeRequest{
+		Project:  link.Project,
+		Iid:      link.IID,
+		Title:    link.Title,
+		WebUrl:   link.WebURL,
+		NoteId:   link.NoteID,
+		LinkedAt: timestamp(&link.CreatedAt),
+	}
+}
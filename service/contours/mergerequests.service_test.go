@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+)
+
+func TestMarkdownCell(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "group/project", want: "group/project"},
+		{value: "review|feature", want: `review\|feature`},
+		{value: "https://example.com/?a=1|2", want: `https://example.com/?a=1\|2`},
+		{value: "two\nlines\r\n", want: "two lines  "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := markdownCell(tt.value); got != tt.want {
+				t.Errorf("markdownCell(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContourNoteEscapesName(t *testing.T) {
+	note := contourNote(context.Background(), &contours.ContourInfo{Name: "review|feature\n# title"})
+	want := "This merge request can be tested in the contour **review\\|feature # title**\n\n"
+	if !strings.HasPrefix(note, want) {
+		t.Errorf("contourNote() = %q, want it to start with %q", note, want)
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/xanzy/go-gitlab"
+)
+
+// PendingChanges of every contour service
+func PendingChanges(ctx context.Context, in *contours.ContourId) (*contours.ContourPendingChanges, error) {
+	contour, err := initRepo(ctx).Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	git, err := initGitlab(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	providers, err := initProviders(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*contours.ServicePendingChanges, 0, len(contour.GetServices()))
+	for _, service := range contour.GetServices() {
+		if err := providers.requireGitlab(service.GetId()); err != nil {
+			changes = append(changes, &contours.ServicePendingChanges{
+				ServiceId: service.GetId(),
+				Project:   service.GetProject(),
+				Error:     err.Error(),
+			})
+			continue
+		}
+		changes = append(changes, servicePendingChanges(git, service))
+	}
+	return &contours.ContourPendingChanges{Services: changes}, nil
+}
+
+func servicePendingChanges(git *gitlabClient.Client, service *contours.ServiceInfo) *contours.ServicePendingChanges {
+	changes := &contours.ServicePendingChanges{
+		ServiceId: service.GetId(),
+		Project:   service.GetProject(),
+	}
+	project, err := git.GetProject(service.GetProject())
+	if err != nil {
+		changes.Error = err.Error()
+		return changes
+	}
+	changes.DefaultBranch = project.DefaultBranch
+	deployment, err := lastDeployment(git, service)
+	if err != nil {
+		changes.Error = err.Error()
+		return changes
+	}
+	changes.DeployedSha = deployment.SHA
+	mrs, err := mergeRequestsBetween(git, service.GetProject(), deployment.SHA, project.DefaultBranch)
+	if err != nil {
+		changes.Error = err.Error()
+		return changes
+	}
+	for _, mr := range mrs {
+		changes.MergeRequests = append(changes.MergeRequests, mergeRequestInfo(mr))
+	}
+	return changes
+}
+
+// mergeRequestsBetween returns merge requests into the `to` branch
+// whose commits are reachable from `to` but not from `from`
+func mergeRequestsBetween(git *gitlabClient.Client, project int64, from, to string) ([]*gitlab.MergeRequest, error) {
+	compare, err := git.Compare(project, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return mergeRequestsOf(git, project, compare.Commits, to)
+}
+
+// mergeRequestsOf returns merge requests into the branch that brought any of the commits
+func mergeRequestsOf(git *gitlabClient.Client, project int64, newCommits []*gitlab.Commit, branch string) ([]*gitlab.MergeRequest, error) {
+	if len(newCommits) == 0 {
+		return nil, nil
+	}
+	commits := make(map[string]bool, len(newCommits))
+	// Only merge requests updated after the oldest new commit can contain it
+	since := newCommits[0].CreatedAt
+	for _, commit := range newCommits {
+		commits[commit.ID] = true
+		if commit.CreatedAt != nil && since != nil && commit.CreatedAt.Before(*since) {
+			since = commit.CreatedAt
+		}
+	}
+	merged, err := git.ListMergedMergeRequests(project, branch, since)
+	if err != nil {
+		return nil, err
+	}
+	var mrs []*gitlab.MergeRequest
+	for _, mr := range merged {
+		// Merge commits, squash commits and fast-forwarded heads are all possible
+		if commits[mr.MergeCommitSHA] || commits[mr.SquashCommitSHA] || commits[mr.SHA] {
+			mrs = append(mrs, mr)
+		}
+	}
+	return mrs, nil
+}
+
+func mergeRequestInfo(mr *gitlab.MergeRequest) *contours.MergeRequestInfo {
+	info := &contours.MergeRequestInfo{
+		Iid:      int64(mr.IID),
+		Title:    mr.Title,
+		MergedAt: timestamp(mr.MergedAt),
+		WebUrl:   mr.WebURL,
+	}
+	if mr.Author != nil {
+		info.Author = mr.Author.Username
+	}
+	return info
+}
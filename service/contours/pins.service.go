@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/badhouseplants/envspotting-go-proto/models/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PinServices of a contour, every pin must resolve to a commit of the service project
+func PinServices(ctx context.Context, in *contours.ServicePins) (*common.EmptyMessage, error) {
+	contourID := in.GetContourId()
+	repo := initRepo(ctx)
+	contour, err := repo.Get(ctx, &contours.ContourId{Id: contourID})
+	if err != nil {
+		return nil, err
+	}
+	services := make(map[string]*contours.ServiceInfo, len(contour.GetServices()))
+	for _, service := range contour.GetServices() {
+		services[service.GetId()] = service
+	}
+	providers, err := initProviders(ctx, contourID)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*string, len(in.GetPins()))
+	for _, pin := range in.GetPins() {
+		service, ok := services[pin.GetServiceId()]
+		if !ok {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("service %s can't be found in the contour %s", pin.GetServiceId(), contourID))
+		}
+		if pin.GetRef() == "" {
+			stored[pin.GetServiceId()] = nil
+			continue
+		}
+		provider, err := providers.forService(ctx, pin.GetServiceId())
+		if err != nil {
+			return nil, err
+		}
+		_, err = provider.ResolveRef(ctx, service.GetProject(), pin.GetRef())
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("ref %s can't be found in the project %d", pin.GetRef(), service.GetProject()))
+		} else if err != nil {
+			return nil, err
+		}
+		ref := pin.GetRef()
+		stored[pin.GetServiceId()] = &ref
+	}
+	if err := repo.SetServicePins(ctx, contourID, stored); err != nil {
+		return nil, err
+	}
+	return &common.EmptyMessage{}, nil
+}
+
+// CheckDrift compares pinned refs of contour services with their actual deployments.
+// Services without a pin are skipped
+func CheckDrift(ctx context.Context, in *contours.ContourId) (*contours.ContourPinsDrift, error) {
+	repo := initRepo(ctx)
+	contour, err := repo.Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	pins, err := repo.GetServicePins(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	providers, err := initProviders(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	drifts := &contours.ContourPinsDrift{}
+	for _, service := range contour.GetServices() {
+		pin, ok := pins[service.GetId()]
+		if !ok {
+			continue
+		}
+		drift := &contours.ServicePinDrift{
+			ServiceId:   service.GetId(),
+			Project:     service.GetProject(),
+			Environment: service.GetEnvironment(),
+			Pin:         pin,
+		}
+		if err := checkServiceDrift(ctx, providers, service, drift); err != nil {
+			drift.Error = err.Error()
+		}
+		drifts.Services = append(drifts.Services, drift)
+	}
+	return drifts, nil
+}
+
+func checkServiceDrift(ctx context.Context, providers *serviceProviders, service *contours.ServiceInfo, drift *contours.ServicePinDrift) error {
+	provider, err := providers.forService(ctx, service.GetId())
+	if err != nil {
+		return err
+	}
+	drift.PinnedSha, err = provider.ResolveRef(ctx, service.GetProject(), drift.GetPin())
+	if err != nil {
+		return err
+	}
+	env, err := provider.GetEnvironment(ctx, service.GetProject(), service.GetEnvironment())
+	if err != nil {
+		return err
+	}
+	if env.LastDeployment == nil {
+		return nil
+	}
+	drift.DeployedRef = env.LastDeployment.Ref
+	drift.DeployedSha = env.LastDeployment.SHA
+	drift.InSync = sameCommit(drift.GetPinnedSha(), drift.GetDeployedSha())
+	return nil
+}
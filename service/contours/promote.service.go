@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+)
+
+// Pipeline variables passed to promotion pipelines
+const (
+	promoteEnvironmentVariable = "ENVSPOTTING_TARGET_ENVIRONMENT"
+	promoteSHAVariable         = "ENVSPOTTING_SHA"
+)
+
+// Promote versions deployed in the source contour into the target one.
+// Gitlab can only run pipelines on refs, so the pipeline runs on the ref of the
+// source deployment and gets the deployed sha as a variable.
+// Projects whose ref has moved past the deployed sha aren't promoted, the pipeline
+// would build another commit than the one deployed in the source contour
+func Promote(ctx context.Context, in *contours.ContoursToPromote) (*contours.PromoteReport, error) {
+	sourceID := &contours.ContourId{Id: in.GetSourceContourId()}
+	targetID := &contours.ContourId{Id: in.GetTargetContourId()}
+	repo := initRepo(ctx)
+	source, err := repo.Get(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := repo.Get(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	sourceProviders, err := initProviders(ctx, sourceID.GetId())
+	if err != nil {
+		return nil, err
+	}
+	targetProviders, err := initProviders(ctx, targetID.GetId())
+	if err != nil {
+		return nil, err
+	}
+	sourceServices := servicesByProject(source)
+	var steps []*contours.PromoteStep
+	for _, targetService := range target.GetServices() {
+		sourceService, ok := sourceServices[targetService.GetProject()]
+		if !ok {
+			continue
+		}
+		sourceGit, targetGit, err := gitlabPair(ctx, sourceProviders, sourceService, targetProviders, targetService)
+		if err != nil {
+			steps = append(steps, &contours.PromoteStep{Project: targetService.GetProject(), Error: err.Error()})
+			continue
+		}
+		step := planPromoteStep(sourceGit, targetGit, sourceService, targetService)
+		if step.Error == "" && !in.GetDryRun() {
+			pipeline, err := targetGit.CreatePipeline(step.Project, step.Ref, map[string]string{
+				promoteEnvironmentVariable: step.TargetEnvironment,
+				promoteSHAVariable:         step.Sha,
+			})
+			if err != nil {
+				step.Error = err.Error()
+			} else {
+				step.PipelineId = int64(pipeline.ID)
+			}
+		}
+		steps = append(steps, step)
+	}
+	return &contours.PromoteReport{Steps: steps}, nil
+}
+
+func planPromoteStep(sourceGit, targetGit *gitlabClient.Client, source, target *contours.ServiceInfo) *contours.PromoteStep {
+	step := &contours.PromoteStep{Project: target.GetProject()}
+	sourceEnv, err := sourceGit.GetEnvironment(source.GetProject(), source.GetEnvironment())
+	if err != nil {
+		step.Error = err.Error()
+		return step
+	}
+	if sourceEnv.LastDeployment == nil {
+		step.Error = "source environment has never been deployed"
+		return step
+	}
+	targetEnv, err := targetGit.GetEnvironment(target.GetProject(), target.GetEnvironment())
+	if err != nil {
+		step.Error = err.Error()
+		return step
+	}
+	step.Ref = sourceEnv.LastDeployment.Ref
+	step.Sha = sourceEnv.LastDeployment.SHA
+	step.TargetEnvironment = targetEnv.Name
+	head, err := targetGit.GetCommit(target.GetProject(), step.Ref)
+	if err != nil {
+		step.Error = err.Error()
+		return step
+	}
+	if !sameCommit(head.ID, step.Sha) {
+		step.Error = fmt.Sprintf("%s has moved past the deployed commit %s to %s, a pipeline on it wouldn't deploy the same version", step.Ref, step.Sha, head.ID)
+	}
+	return step
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	appsService "github.com/badhouseplants/envspotting-apps/service/applications"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serviceProviders resolves providers of contour services,
+// one provider is shared by all services of the same type
+type serviceProviders struct {
+	contourID string
+	appID     *applications.AppId
+	types     map[string]string
+	providers map[string]scm.Provider
+	// git is the gitlab client of the contour, it's built by the first gitlab service
+	git    *gitlabClient.Client
+	gitErr error
+}
+
+// initProviders for services of a contour
+func initProviders(ctx context.Context, contourID string) (*serviceProviders, error) {
+	appID, err := GetAppIDByContourID(ctx, contourID)
+	if err != nil {
+		return nil, err
+	}
+	types, err := initRepo(ctx).GetServiceProviders(ctx, contourID)
+	if err != nil {
+		return nil, err
+	}
+	return &serviceProviders{
+		contourID: contourID,
+		appID:     appID,
+		types:     types,
+		providers: map[string]scm.Provider{},
+	}, nil
+}
+
+// forService returns the provider of a service, services without a type are on gitlab
+func (p *serviceProviders) forService(ctx context.Context, serviceID string) (scm.Provider, error) {
+	return p.forType(ctx, p.types[serviceID])
+}
+
+// requireGitlab returns an error for services on other providers.
+// Features built on gitlab apis report it per service and go on with the others
+func (p *serviceProviders) requireGitlab(serviceID string) error {
+	if providerType, _ := scm.ValidateType(p.types[serviceID]); providerType != scm.ProviderGitlab {
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("service %s is on %s, only services on gitlab are supported", serviceID, p.types[serviceID]))
+	}
+	return nil
+}
+
+// gitlab returns the gitlab client for a service on gitlab. The client is only built
+// when a service needs it, so contours without gitlab services don't need a connection
+func (p *serviceProviders) gitlab(ctx context.Context, serviceID string) (*gitlabClient.Client, error) {
+	if err := p.requireGitlab(serviceID); err != nil {
+		return nil, err
+	}
+	if p.git == nil && p.gitErr == nil {
+		p.git, p.gitErr = initGitlab(ctx, p.contourID)
+	}
+	return p.git, p.gitErr
+}
+
+func (p *serviceProviders) forType(ctx context.Context, providerType string) (scm.Provider, error) {
+	providerType, err := scm.ValidateType(providerType)
+	if err != nil {
+		return nil, err
+	}
+	if provider, ok := p.providers[providerType]; ok {
+		return provider, nil
+	}
+	provider, err := appsService.Provider(ctx, p.appID, providerType)
+	if err != nil {
+		return nil, err
+	}
+	p.providers[providerType] = provider
+	return provider, nil
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CommitInfo is a commit of a project
+type CommitInfo struct {
+	SHA         string
+	Title       string
+	Author      string
+	CommittedAt *time.Time
+	WebURL      string
+}
+
+// ProjectReleaseNotes are changes of one project deployed in `from` but not yet in `to`
+type ProjectReleaseNotes struct {
+	Project int64
+	Path    string
+	FromSHA string
+	ToSHA   string
+	// New is set when the project isn't deployed in `to` at all
+	New           bool
+	MergeRequests []*contours.MergeRequestInfo
+	Commits       []*CommitInfo
+	// Truncated is set when the limit dropped some commits or merge requests
+	Truncated bool
+	// Error is set when changes of this project couldn't be found
+	Error string
+}
+
+// ReleaseNotes of everything deployed in `from` but not yet in `to`, grouped by project
+func ReleaseNotes(ctx context.Context, opts *contours.ReleaseNotesOptions) (*contours.ReleaseNotesDocument, error) {
+	format := opts.GetFormat()
+	if _, ok := contours.ReleaseNotesFormat_name[int32(format)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown release notes format: %d", format))
+	}
+	if opts.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit can't be negative")
+	}
+	exclude, err := compileOptionalRegexp(opts.GetExcludeTitle())
+	if err != nil {
+		return nil, err
+	}
+	repo := initRepo(ctx)
+	from, err := repo.Get(ctx, &contours.ContourId{Id: opts.GetFromContourId()})
+	if err != nil {
+		return nil, err
+	}
+	to, err := repo.Get(ctx, &contours.ContourId{Id: opts.GetToContourId()})
+	if err != nil {
+		return nil, err
+	}
+	fromProviders, err := initProviders(ctx, opts.GetFromContourId())
+	if err != nil {
+		return nil, err
+	}
+	toProviders, err := initProviders(ctx, opts.GetToContourId())
+	if err != nil {
+		return nil, err
+	}
+	toServices := servicesByProject(to)
+	var notes []*ProjectReleaseNotes
+	for project, fromService := range servicesByProject(from) {
+		var (
+			toService      = toServices[project]
+			fromGit, toGit *gitlabClient.Client
+			err            error
+		)
+		if toService != nil {
+			fromGit, toGit, err = gitlabPair(ctx, fromProviders, fromService, toProviders, toService)
+		} else {
+			fromGit, err = fromProviders.gitlab(ctx, fromService.GetId())
+		}
+		if err != nil {
+			notes = append(notes, &ProjectReleaseNotes{Project: project, Error: err.Error()})
+			continue
+		}
+		notes = append(notes, projectReleaseNotes(fromGit, toGit, fromService, toService, exclude, int(opts.GetLimit())))
+	}
+	// Keep the document stable, maps are iterated randomly
+	sort.Slice(notes, func(i, j int) bool { return notes[i].Project < notes[j].Project })
+	document := &contours.ReleaseNotesDocument{Format: format}
+	if format == contours.ReleaseNotesFormat_RELEASE_NOTES_FORMAT_JSON {
+		content, err := json.MarshalIndent(notes, "", "  ")
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		document.Content = string(content)
+		return document, nil
+	}
+	document.Content = releaseNotesMarkdown(from, to, notes)
+	return document, nil
+}
+
+func projectReleaseNotes(fromGit, toGit *gitlabClient.Client, fromService, toService *contours.ServiceInfo, exclude *regexp.Regexp, limit int) *ProjectReleaseNotes {
+	notes := &ProjectReleaseNotes{Project: fromService.GetProject()}
+	project, err := fromGit.GetProject(fromService.GetProject())
+	if err != nil {
+		notes.Error = err.Error()
+		return notes
+	}
+	notes.Path = project.PathWithNamespace
+	if notes.FromSHA, err = deployedSHA(fromGit, fromService); err != nil {
+		notes.Error = err.Error()
+		return notes
+	}
+	if toService == nil {
+		notes.New = true
+		return notes
+	}
+	if notes.ToSHA, err = deployedSHA(toGit, toService); err != nil {
+		notes.Error = err.Error()
+		return notes
+	}
+	if notes.FromSHA == notes.ToSHA {
+		return notes
+	}
+	compare, err := fromGit.Compare(fromService.GetProject(), notes.ToSHA, notes.FromSHA)
+	if err != nil {
+		notes.Error = err.Error()
+		return notes
+	}
+	mrs, err := mergeRequestsOf(fromGit, fromService.GetProject(), compare.Commits, project.DefaultBranch)
+	if err != nil {
+		notes.Error = err.Error()
+		return notes
+	}
+	for _, mr := range mrs {
+		if exclude != nil && exclude.MatchString(mr.Title) {
+			continue
+		}
+		if limit > 0 && len(notes.MergeRequests) == limit {
+			notes.Truncated = true
+			break
+		}
+		notes.MergeRequests = append(notes.MergeRequests, mergeRequestInfo(mr))
+	}
+	// Compare lists commits oldest first, notes show the newest first
+	for i := len(compare.Commits) - 1; i >= 0; i-- {
+		commit := compare.Commits[i]
+		if exclude != nil && exclude.MatchString(commit.Title) {
+			continue
+		}
+		if limit > 0 && len(notes.Commits) == limit {
+			notes.Truncated = true
+			break
+		}
+		notes.Commits = append(notes.Commits, &CommitInfo{
+			SHA:         commit.ID,
+			Title:       commit.Title,
+			Author:      commit.AuthorName,
+			CommittedAt: commit.CommittedDate,
+			WebURL:      commit.WebURL,
+		})
+	}
+	return notes
+}
+
+func releaseNotesMarkdown(from, to *contours.ContourInfo, notes []*ProjectReleaseNotes) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Release notes: %s → %s\n", from.GetName(), to.GetName())
+	for _, project := range notes {
+		name := project.Path
+		if name == "" {
+			name = fmt.Sprint(project.Project)
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", name)
+		switch {
+		case project.Error != "":
+			fmt.Fprintf(&b, "Changes can't be listed: %s\n", project.Error)
+			continue
+		case project.New:
+			fmt.Fprintf(&b, "Not deployed in %s yet, `%s` is released\n", to.GetName(), shortSHA(project.FromSHA))
+			continue
+		case project.FromSHA == project.ToSHA:
+			b.WriteString("No changes\n")
+			continue
+		}
+		fmt.Fprintf(&b, "`%s` → `%s`\n", shortSHA(project.ToSHA), shortSHA(project.FromSHA))
+		if len(project.MergeRequests) > 0 {
+			b.WriteString("\n### Merge requests\n\n")
+			for _, mr := range project.MergeRequests {
+				fmt.Fprintf(&b, "- [!%d](%s) %s", mr.GetIid(), mr.GetWebUrl(), mr.GetTitle())
+				if mr.GetAuthor() != "" {
+					fmt.Fprintf(&b, " (@%s)", mr.GetAuthor())
+				}
+				b.WriteString("\n")
+			}
+		}
+		if len(project.Commits) > 0 {
+			b.WriteString("\n### Commits\n\n")
+			for _, commit := range project.Commits {
+				fmt.Fprintf(&b, "- [`%s`](%s) %s (%s)\n", shortSHA(commit.SHA), commit.WebURL, commit.Title, commit.Author)
+			}
+		}
+		if project.Truncated {
+			b.WriteString("\n_Some changes are not listed_\n")
+		}
+	}
+	return b.String()
+}
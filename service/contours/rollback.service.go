@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RollbackService to the last version deployed successfully before the live one
+// by retrying the deploy job of it
+func RollbackService(ctx context.Context, in *contours.ServiceIdAndContourId) (*contours.ServiceRollback, error) {
+	repo := initRepo(ctx)
+	contour, err := repo.Get(ctx, &contours.ContourId{Id: in.GetContourId()})
+	if err != nil {
+		return nil, err
+	}
+	var service *contours.ServiceInfo
+	for _, s := range contour.GetServices() {
+		if s.GetId() == in.GetServiceId() {
+			service = s
+			break
+		}
+	}
+	if service == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("service %s can't be found in the contour %s", in.GetServiceId(), in.GetContourId()))
+	}
+	providers, err := repo.GetServiceProviders(ctx, in.GetContourId())
+	if err != nil {
+		return nil, err
+	}
+	if providerType, _ := scm.ValidateType(providers[service.GetId()]); providerType != scm.ProviderGitlab {
+		return nil, status.Error(codes.FailedPrecondition, "only services on gitlab can be rolled back")
+	}
+	git, err := initGitlab(ctx, in.GetContourId())
+	if err != nil {
+		return nil, err
+	}
+	env, err := git.GetEnvironment(service.GetProject(), service.GetEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	// The live version is the last successful deployment, a failed or running one changed nothing yet
+	live, err := git.LastSuccessfulDeployment(service.GetProject(), env.Name)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("environment %s of the project %d has never been deployed successfully", env.Name, service.GetProject()))
+	} else if err != nil {
+		return nil, err
+	}
+	previous, err := git.SuccessfulDeploymentBefore(service.GetProject(), env.Name, live)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("environment %s of the project %d has no earlier successful deployment of another version", env.Name, service.GetProject()))
+	} else if err != nil {
+		return nil, err
+	}
+	job, err := git.RetryJob(service.GetProject(), previous.Deployable.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &contours.ServiceRollback{
+		ServiceId:    service.GetId(),
+		DeploymentId: int64(previous.ID),
+		Ref:          previous.Ref,
+		Sha:          previous.SHA,
+		JobId:        int64(job.ID),
+	}, nil
+}
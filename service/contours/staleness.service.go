@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Staleness of a contour compared with default branches of its service projects
+func Staleness(ctx context.Context, in *contours.ContourId) (*contours.ContourStaleness, error) {
+	contour, err := initRepo(ctx).Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	providers, err := initProviders(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	staleness := &contours.ContourStaleness{}
+	for _, service := range contour.GetServices() {
+		git, err := providers.gitlab(ctx, service.GetId())
+		if err != nil {
+			staleness.Services = append(staleness.Services, &contours.ServiceStaleness{
+				ServiceId: service.GetId(),
+				Project:   service.GetProject(),
+				Error:     err.Error(),
+			})
+			continue
+		}
+		serviceStaleness := serviceStaleness(git, service, now)
+		staleness.Services = append(staleness.Services, serviceStaleness)
+		if serviceStaleness.GetError() != "" {
+			continue
+		}
+		if serviceStaleness.GetCommitsBehind() > staleness.GetMaxCommitsBehind() {
+			staleness.MaxCommitsBehind = serviceStaleness.GetCommitsBehind()
+		}
+		if age := serviceStaleness.GetDeployedCommitAge(); age.AsDuration() > staleness.GetMaxDeployedCommitAge().AsDuration() {
+			staleness.MaxDeployedCommitAge = age
+		}
+		if oldest := serviceStaleness.GetOldestUndeployedCommit(); oldest != nil &&
+			(staleness.OldestUndeployedCommit == nil || oldest.AsTime().Before(staleness.OldestUndeployedCommit.AsTime())) {
+			staleness.OldestUndeployedCommit = oldest
+		}
+	}
+	return staleness, nil
+}
+
+func serviceStaleness(git *gitlabClient.Client, service *contours.ServiceInfo, now time.Time) *contours.ServiceStaleness {
+	staleness := &contours.ServiceStaleness{
+		ServiceId: service.GetId(),
+		Project:   service.GetProject(),
+	}
+	project, err := git.GetProject(service.GetProject())
+	if err != nil {
+		staleness.Error = err.Error()
+		return staleness
+	}
+	staleness.DefaultBranch = project.DefaultBranch
+	sha, err := deployedSHA(git, service)
+	if err != nil {
+		staleness.Error = err.Error()
+		return staleness
+	}
+	staleness.DeployedSha = sha
+	deployed, err := git.GetCommit(service.GetProject(), sha)
+	if err != nil {
+		staleness.Error = err.Error()
+		return staleness
+	}
+	if deployed.CommittedDate != nil {
+		staleness.DeployedCommitAge = durationpb.New(now.Sub(*deployed.CommittedDate))
+	}
+	compare, err := git.Compare(service.GetProject(), sha, project.DefaultBranch)
+	if err != nil {
+		staleness.Error = err.Error()
+		return staleness
+	}
+	staleness.CommitsBehind = int32(len(compare.Commits))
+	var oldest *time.Time
+	for _, commit := range compare.Commits {
+		if commit.CommittedDate == nil {
+			continue
+		}
+		if oldest == nil || commit.CommittedDate.Before(*oldest) {
+			oldest = commit.CommittedDate
+		}
+	}
+	staleness.OldestUndeployedCommit = timestamp(oldest)
+	return staleness
+}
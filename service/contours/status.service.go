@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	deploymentsRepo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/workers/poller"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const shortSHALength = 8
+
+// GetStatus of every service in a contour.
+// States stored by the poller and webhooks are used, providers are only asked about services without one
+func GetStatus(ctx context.Context, in *contours.ContourId) (*contours.ContourStatus, error) {
+	repo := initRepo(ctx)
+	contour, err := repo.Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	states, err := initStateRepo(ctx).ListByContour(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*deploymentsRepo.DeploymentState, len(states))
+	for _, state := range states {
+		stored[state.ServiceID] = state
+	}
+	var (
+		providers *serviceProviders
+		wg        sync.WaitGroup
+		statuses  = make([]*contours.ServiceStatus, len(contour.GetServices()))
+	)
+	// Ask providers for every service at once, each goroutine owns its own slot
+	for i, service := range contour.GetServices() {
+		if state, ok := stored[service.GetId()]; ok {
+			statuses[i] = statusFromState(service, state, time.Now())
+			continue
+		}
+		if providers == nil {
+			if providers, err = initProviders(ctx, in.GetId()); err != nil {
+				return nil, err
+			}
+		}
+		provider, err := providers.forService(ctx, service.GetId())
+		if err != nil {
+			return nil, err
+		}
+		wg.Add(1)
+		go func(i int, service *contours.ServiceInfo) {
+			defer wg.Done()
+			statuses[i] = getServiceStatus(ctx, provider, service)
+		}(i, service)
+	}
+	wg.Wait()
+	return &contours.ContourStatus{
+		ContourId: in.GetId(),
+		Services:  statuses,
+	}, nil
+}
+
+func getServiceStatus(ctx context.Context, provider scm.Provider, service *contours.ServiceInfo) *contours.ServiceStatus {
+	serviceStatus := &contours.ServiceStatus{
+		ServiceId:   service.GetId(),
+		Project:     service.GetProject(),
+		Environment: service.GetEnvironment(),
+	}
+	env, err := provider.GetEnvironment(ctx, service.GetProject(), service.GetEnvironment())
+	if err != nil {
+		serviceStatus.Error = err.Error()
+		return serviceStatus
+	}
+	serviceStatus.ExternalUrl = env.ExternalURL
+	serviceStatus.UpdatedAt = timestamppb.Now()
+	deployment := env.LastDeployment
+	if deployment == nil {
+		return serviceStatus
+	}
+	serviceStatus.Ref = deployment.Ref
+	serviceStatus.ShortSha = shortSHA(deployment.SHA)
+	serviceStatus.Status = deployment.Status
+	serviceStatus.FinishedAt = timestamp(deployment.FinishedAt)
+	serviceStatus.Deployer = deployment.Deployer
+	return serviceStatus
+}
+
+// statusFromState of a service, it's stale if nothing has updated the state for status_stale_after.
+// That happens when the poller is disabled or keeps failing for the service
+func statusFromState(service *contours.ServiceInfo, state *deploymentsRepo.DeploymentState, now time.Time) *contours.ServiceStatus {
+	return &contours.ServiceStatus{
+		ServiceId:   service.GetId(),
+		Project:     service.GetProject(),
+		Environment: service.GetEnvironment(),
+		Ref:         state.Ref,
+		ShortSha:    shortSHA(state.SHA),
+		Status:      state.Status,
+		Deployer:    state.Deployer,
+		FinishedAt:  timestamp(state.FinishedAt),
+		ExternalUrl: state.ExternalURL,
+		UpdatedAt:   timestamppb.New(state.UpdatedAt),
+		Stale:       now.Sub(state.UpdatedAt) > viper.GetDuration("status_stale_after"),
+	}
+}
+
+// RefreshContour deployment states right away, even for services the poller is backing off from
+func RefreshContour(ctx context.Context, in *contours.ContourId) (*contours.ContourStatus, error) {
+	contour, err := initRepo(ctx).Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	appID, err := GetAppIDByContourID(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	providers, err := initRepo(ctx).GetServiceProviders(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	services := make([]*deploymentsRepo.ContourService, 0, len(contour.GetServices()))
+	for _, service := range contour.GetServices() {
+		services = append(services, &deploymentsRepo.ContourService{
+			AppID:     appID.GetId(),
+			ContourID: in.GetId(),
+			Provider:  providers[service.GetId()],
+			Service:   service,
+		})
+	}
+	errs := poller.Get().Refresh(ctx, services, true)
+	statuses, err := GetStatus(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	for _, serviceStatus := range statuses.GetServices() {
+		if err, ok := errs[serviceStatus.GetServiceId()]; ok {
+			serviceStatus.Error = err.Error()
+		}
+	}
+	return statuses, nil
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > shortSHALength {
+		return sha[:shortSHALength]
+	}
+	return sha
+}
+
+// timestamp of an optional time, nil stays nil
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
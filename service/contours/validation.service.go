@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errUnknownServices = "some services can't be found"
+
+// validateServices checks that every project and environment exists in the provider
+// and returns projects of the services in the same order.
+// Unknown ones are reported together as field violations of one InvalidArgument error.
+// Services are completed with names of their environments.
+// If createMissing is set, environments that can't be found are created from their names
+// once all services are valid, and the services are updated with ids of the created ones
+func validateServices(ctx context.Context, provider scm.Provider, services []*contours.ServiceWithoutId, createMissing bool) ([]*scm.Project, error) {
+	var (
+		violations []*errdetails.BadRequest_FieldViolation
+		projects   = make([]*scm.Project, len(services))
+		missing    []*contours.ServiceWithoutId
+	)
+	for i, service := range services {
+		project, violation, err := validateService(ctx, provider, service)
+		if err != nil {
+			return nil, err
+		}
+		if violation != nil && violation.Field == "environment" && createMissing {
+			violation = nil
+			if service.GetEnvironmentName() == "" {
+				violation = &errdetails.BadRequest_FieldViolation{
+					Field:       "environment_name",
+					Description: fmt.Sprintf("environment %d can't be found in the project %d and can't be created without a name", service.GetEnvironment(), service.GetProject()),
+				}
+			} else {
+				missing = append(missing, service)
+			}
+		}
+		if violation != nil {
+			violation.Field = fmt.Sprintf("services[%d].%s", i, violation.Field)
+			violations = append(violations, violation)
+		}
+		projects[i] = project
+	}
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, errUnknownServices).
+			WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+		if err != nil {
+			logger.GetGrpcLogger(ctx).Error(err)
+			return nil, status.Error(codes.InvalidArgument, errUnknownServices)
+		}
+		return nil, st.Err()
+	}
+	for _, service := range missing {
+		env, err := provider.CreateEnvironment(ctx, service.GetProject(), service.GetEnvironmentName())
+		if err != nil {
+			return nil, err
+		}
+		service.Environment = env.ID
+		service.EnvironmentName = env.Name
+	}
+	return projects, nil
+}
+
+// validateService returns a violation if the service is unknown to the provider,
+// and an error if the provider couldn't be asked at all.
+// The project is returned even if only the environment is unknown
+func validateService(ctx context.Context, provider scm.Provider, service *contours.ServiceWithoutId) (*scm.Project, *errdetails.BadRequest_FieldViolation, error) {
+	project, err := provider.GetProject(ctx, service.GetProject())
+	if status.Code(err) == codes.NotFound {
+		return nil, &errdetails.BadRequest_FieldViolation{
+			Field:       "project",
+			Description: fmt.Sprintf("project can't be found: %d", service.GetProject()),
+		}, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	violation := &errdetails.BadRequest_FieldViolation{
+		Field:       "environment",
+		Description: fmt.Sprintf("environment %d can't be found in the project %d", service.GetEnvironment(), service.GetProject()),
+	}
+	// Services that only have a name are there to create their environments
+	if service.GetEnvironment() == 0 {
+		return project, violation, nil
+	}
+	env, err := provider.GetEnvironment(ctx, service.GetProject(), service.GetEnvironment())
+	if status.Code(err) == codes.NotFound {
+		return project, violation, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	service.EnvironmentName = env.Name
+	return project, nil, nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gitlabStandIn serves project 1 with the environment 10 named "staging"
+type gitlabStandIn struct {
+	mu      sync.Mutex
+	created []string
+}
+
+func (g *gitlabStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	reply := func(v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/1":
+		reply(map[string]interface{}{"id": 1, "path_with_namespace": "group/project", "default_branch": "main"})
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/1/environments/10":
+		reply(map[string]interface{}{"id": 10, "name": "staging"})
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/1/environments":
+		if r.URL.Query().Get("name") == "staging" {
+			reply([]map[string]interface{}{{"id": 10, "name": "staging"}})
+			return
+		}
+		reply([]map[string]interface{}{})
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/1/environments":
+		var opts struct {
+			Name string `json:"name"`
+		}
+		json.NewDecoder(r.Body).Decode(&opts)
+		g.mu.Lock()
+		g.created = append(g.created, opts.Name)
+		g.mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		reply(map[string]interface{}{"id": 20, "name": opts.Name})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		reply(map[string]string{"message": "404 Not Found"})
+	}
+}
+
+func newGitlabStandIn(t *testing.T) (*gitlabStandIn, scm.Provider) {
+	standIn := &gitlabStandIn{}
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+	git, err := gitlabClient.NewClient(&gitlabClient.Connection{URL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return standIn, scm.NewGitlab(git)
+}
+
+func TestValidateServices(t *testing.T) {
+	tests := []struct {
+		name          string
+		services      []*contours.ServiceWithoutId
+		createMissing bool
+		// violations are fields expected in the InvalidArgument error, nil if there must be no error
+		violations   []string
+		created      []string
+		environments []int64
+		names        []string
+	}{
+		{
+			name:         "known services",
+			services:     []*contours.ServiceWithoutId{{Project: 1, Environment: 10}},
+			environments: []int64{10},
+			names:        []string{"staging"},
+		},
+		{
+			name:       "unknown project",
+			services:   []*contours.ServiceWithoutId{{Project: 2, Environment: 10}},
+			violations: []string{"services[0].project"},
+		},
+		{
+			name:       "unknown environment",
+			services:   []*contours.ServiceWithoutId{{Project: 1, Environment: 10}, {Project: 1, Environment: 11}},
+			violations: []string{"services[1].environment"},
+		},
+		{
+			name: "all violations at once",
+			services: []*contours.ServiceWithoutId{
+				{Project: 2, Environment: 10},
+				{Project: 1, Environment: 11},
+				{Project: 1, Environment: 10},
+			},
+			violations: []string{"services[0].project", "services[1].environment"},
+		},
+		{
+			name:       "missing environment isn't created without the flag",
+			services:   []*contours.ServiceWithoutId{{Project: 1, Environment: 11, EnvironmentName: "review"}},
+			violations: []string{"services[0].environment"},
+		},
+		{
+			name:          "missing environment is created",
+			services:      []*contours.ServiceWithoutId{{Project: 1, Environment: 11, EnvironmentName: "review"}},
+			createMissing: true,
+			created:       []string{"review"},
+			environments:  []int64{20},
+			names:         []string{"review"},
+		},
+		{
+			name:          "environment found by name isn't created again",
+			services:      []*contours.ServiceWithoutId{{Project: 1, EnvironmentName: "staging"}},
+			createMissing: true,
+			environments:  []int64{10},
+			names:         []string{"staging"},
+		},
+		{
+			name:          "missing environment without a name",
+			services:      []*contours.ServiceWithoutId{{Project: 1, Environment: 11}},
+			createMissing: true,
+			violations:    []string{"services[0].environment_name"},
+		},
+		{
+			name: "nothing is created if another service is invalid",
+			services: []*contours.ServiceWithoutId{
+				{Project: 1, Environment: 11, EnvironmentName: "review"},
+				{Project: 2, Environment: 10},
+			},
+			createMissing: true,
+			violations:    []string{"services[1].project"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standIn, provider := newGitlabStandIn(t)
+			projects, err := validateServices(context.Background(), provider, tt.services, tt.createMissing)
+			if tt.violations == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				for i, service := range tt.services {
+					if projects[i].Path != "group/project" {
+						t.Errorf("services[%d] project = %q, want group/project", i, projects[i].Path)
+					}
+					if service.GetEnvironment() != tt.environments[i] {
+						t.Errorf("services[%d] environment = %d, want %d", i, service.GetEnvironment(), tt.environments[i])
+					}
+					if service.GetEnvironmentName() != tt.names[i] {
+						t.Errorf("services[%d] environment name = %q, want %q", i, service.GetEnvironmentName(), tt.names[i])
+					}
+				}
+			} else {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("error = %v, want InvalidArgument", err)
+				}
+				var fields []string
+				for _, detail := range status.Convert(err).Details() {
+					if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+						for _, violation := range badRequest.GetFieldViolations() {
+							fields = append(fields, violation.GetField())
+						}
+					}
+				}
+				if !equalStrings(fields, tt.violations) {
+					t.Errorf("violations = %v, want %v", fields, tt.violations)
+				}
+			}
+			if !equalStrings(standIn.created, tt.created) {
+				t.Errorf("created environments = %v, want %v", standIn.created, tt.created)
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
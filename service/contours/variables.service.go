@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	variablesRepo "github.com/badhouseplants/envspotting-apps/repo/variables"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-apps/tools/secrets"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/badhouseplants/envspotting-go-proto/models/common"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gitlab refuses to mask values shorter than that
+const minMaskedLength = 8
+
+var variableKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+var initVariablesRepo = func(ctx context.Context) variablesRepo.VariableStore {
+	return variablesRepo.VariableRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// SetVariables of a contour, existing keys are overwritten
+func SetVariables(ctx context.Context, in *contours.ContourVariables) (*common.EmptyMessage, error) {
+	stored := make([]*variablesRepo.Variable, 0, len(in.GetVariables()))
+	for _, variable := range in.GetVariables() {
+		if !variableKeyRegexp.MatchString(variable.GetKey()) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("variable key can only contain letters, digits and '_': %s", variable.GetKey()))
+		}
+		if variable.GetMasked() && len(variable.GetValue()) < minMaskedLength {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("masked variable must be at least %d characters long: %s", minMaskedLength, variable.GetKey()))
+		}
+		value, err := secrets.Encrypt([]byte(variable.GetValue()))
+		if err != nil {
+			logger.GetGrpcLogger(ctx).Error(err)
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		stored = append(stored, &variablesRepo.Variable{
+			Key:            variable.GetKey(),
+			EncryptedValue: value,
+			Masked:         variable.GetMasked(),
+		})
+	}
+	if err := initVariablesRepo(ctx).Set(ctx, in.GetContourId(), stored); err != nil {
+		return nil, err
+	}
+	return &common.EmptyMessage{}, nil
+}
+
+// RemoveVariables of a contour, they stay in gitlab until a sync with prune
+func RemoveVariables(ctx context.Context, in *contours.VariableKeys) (*common.EmptyMessage, error) {
+	if err := initVariablesRepo(ctx).Remove(ctx, in.GetContourId(), in.GetKeys()); err != nil {
+		return nil, err
+	}
+	return &common.EmptyMessage{}, nil
+}
+
+// ListVariables of a contour, values of masked ones are left empty
+func ListVariables(ctx context.Context, in *contours.ContourId) (*contours.ContourVariables, error) {
+	variables, err := listVariables(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	for _, variable := range variables {
+		if variable.GetMasked() {
+			variable.Value = ""
+		}
+	}
+	return &contours.ContourVariables{
+		ContourId: in.GetId(),
+		Variables: variables,
+	}, nil
+}
+
+func listVariables(ctx context.Context, contourID string) ([]*contours.Variable, error) {
+	stored, err := initVariablesRepo(ctx).List(ctx, contourID)
+	if err != nil {
+		return nil, err
+	}
+	variables := make([]*contours.Variable, 0, len(stored))
+	for _, variable := range stored {
+		value, err := secrets.Decrypt(variable.EncryptedValue)
+		if err != nil {
+			logger.GetGrpcLogger(ctx).Error(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		variables = append(variables, &contours.Variable{
+			Key:    variable.Key,
+			Value:  string(value),
+			Masked: variable.Masked,
+		})
+	}
+	return variables, nil
+}
+
+// SyncVariables of a contour into every service project as variables
+// scoped to the service environment, and return changes it made
+func SyncVariables(ctx context.Context, opts *contours.VariablesSync) (*contours.VariablesSyncReport, error) {
+	repo := initRepo(ctx)
+	contour, err := repo.Get(ctx, &contours.ContourId{Id: opts.GetContourId()})
+	if err != nil {
+		return nil, err
+	}
+	providers, err := repo.GetServiceProviders(ctx, opts.GetContourId())
+	if err != nil {
+		return nil, err
+	}
+	variables, err := listVariables(ctx, opts.GetContourId())
+	if err != nil {
+		return nil, err
+	}
+	git, err := initGitlab(ctx, opts.GetContourId())
+	if err != nil {
+		return nil, err
+	}
+	report := &contours.VariablesSyncReport{}
+	for _, service := range contour.GetServices() {
+		if providerType, _ := scm.ValidateType(providers[service.GetId()]); providerType != scm.ProviderGitlab {
+			report.Changes = append(report.Changes, &contours.VariableChange{
+				ServiceId: service.GetId(),
+				Project:   service.GetProject(),
+				Error:     "variables can only be synced to gitlab",
+			})
+			continue
+		}
+		serviceChanges, err := syncServiceVariables(git, service, variables, opts)
+		if err != nil {
+			serviceChanges = append(serviceChanges, &contours.VariableChange{
+				ServiceId: service.GetId(),
+				Project:   service.GetProject(),
+				Error:     err.Error(),
+			})
+		}
+		report.Changes = append(report.Changes, serviceChanges...)
+	}
+	return report, nil
+}
+
+// syncServiceVariables diffs variables of the service environment scope and applies the diff
+func syncServiceVariables(git *gitlabClient.Client, service *contours.ServiceInfo, variables []*contours.Variable, opts *contours.VariablesSync) ([]*contours.VariableChange, error) {
+	env, err := git.GetEnvironment(service.GetProject(), service.GetEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	existing, err := git.ListVariables(service.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	scoped := map[string]*gitlab.ProjectVariable{}
+	for _, variable := range existing {
+		if variable.EnvironmentScope == env.Name {
+			scoped[variable.Key] = variable
+		}
+	}
+	var changes []*contours.VariableChange
+	apply := func(key string, action contours.VariableAction, run func() error) {
+		change := &contours.VariableChange{
+			ServiceId:   service.GetId(),
+			Project:     service.GetProject(),
+			Environment: env.Name,
+			Key:         key,
+			Action:      action,
+		}
+		if !opts.GetDryRun() {
+			if err := run(); err != nil {
+				change.Error = err.Error()
+			}
+		}
+		changes = append(changes, change)
+	}
+	wanted := make(map[string]bool, len(variables))
+	for _, variable := range variables {
+		variable := variable
+		wanted[variable.Key] = true
+		current, ok := scoped[variable.Key]
+		switch {
+		case !ok:
+			apply(variable.Key, contours.VariableAction_VARIABLE_ACTION_CREATE, func() error {
+				_, err := git.CreateVariable(service.GetProject(), &gitlab.CreateProjectVariableOptions{
+					Key:              gitlab.String(variable.Key),
+					Value:            gitlab.String(variable.Value),
+					Masked:           gitlab.Bool(variable.Masked),
+					EnvironmentScope: gitlab.String(env.Name),
+				})
+				return err
+			})
+		case current.Value != variable.Value || current.Masked != variable.Masked:
+			apply(variable.Key, contours.VariableAction_VARIABLE_ACTION_UPDATE, func() error {
+				_, err := git.UpdateVariable(service.GetProject(), variable.Key, env.Name, &gitlab.UpdateProjectVariableOptions{
+					Value:            gitlab.String(variable.Value),
+					Masked:           gitlab.Bool(variable.Masked),
+					EnvironmentScope: gitlab.String(env.Name),
+				})
+				return err
+			})
+		}
+	}
+	if opts.GetPrune() {
+		var unknown []string
+		for key := range scoped {
+			if !wanted[key] {
+				unknown = append(unknown, key)
+			}
+		}
+		sort.Strings(unknown)
+		for _, key := range unknown {
+			key := key
+			apply(key, contours.VariableAction_VARIABLE_ACTION_DELETE, func() error {
+				return git.RemoveVariable(service.GetProject(), key, env.Name)
+			})
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ephemeralRepo "github.com/badhouseplants/envspotting-apps/repo/ephemeral"
+	appsService "github.com/badhouseplants/envspotting-apps/service/applications"
+	contoursService "github.com/badhouseplants/envspotting-apps/service/contours"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var initEphemeralRepo = func(ctx context.Context) ephemeralRepo.EphemeralStore {
+	return ephemeralRepo.EphemeralRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// HandleMergeRequest creates contours for an open merge request by rules of applications
+// and deletes them once the merge request is merged or closed
+func HandleMergeRequest(ctx context.Context, event *gitlab.MergeEvent) error {
+	project := int64(event.Project.ID)
+	iid := int64(event.ObjectAttributes.IID)
+	switch event.ObjectAttributes.Action {
+	case "merge", "close":
+		return deleteEphemeralContours(ctx, project, iid, event.Project.WebURL)
+	case "open", "reopen", "update":
+	default:
+		return nil
+	}
+	rules, err := initEphemeralRepo(ctx).FindRules(ctx, project)
+	if err != nil {
+		return err
+	}
+	existing, err := initEphemeralRepo(ctx).FindContours(ctx, project, iid)
+	if err != nil {
+		return err
+	}
+	contoursByApp := make(map[string]*ephemeralRepo.Contour, len(existing))
+	for _, contour := range existing {
+		contoursByApp[contour.AppID] = contour
+	}
+	log := logger.GetServerLogger()
+	for _, rule := range rules {
+		// One application with a broken connection shouldn't hide the merge request from the others
+		git, err := initGitlab(ctx, rule.AppID)
+		if err != nil {
+			log.Errorf("skipping the application %s: %v", rule.AppID, err)
+			continue
+		}
+		if !sameInstance(git, event.Project.WebURL) {
+			continue
+		}
+		if err := ensureEphemeralContour(ctx, git, rule, contoursByApp[rule.AppID], event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureEphemeralContour creates the contour if there is none and adds services
+// whose environments were deployed since the last event
+func ensureEphemeralContour(ctx context.Context, git *gitlabClient.Client, rule *ephemeralRepo.Rule, contour *ephemeralRepo.Contour, event *gitlab.MergeEvent) error {
+	if contour == nil {
+		var err error
+		if contour, err = createEphemeralContour(ctx, rule, event); err != nil || contour == nil {
+			return err
+		}
+	}
+	if contour.Branch == "" {
+		contour.Branch = event.ObjectAttributes.SourceBranch
+	}
+	return addEphemeralServices(ctx, git, rule, contour)
+}
+
+// addDeployedEphemeralServices adds the deployed service to contours of merge requests
+// whose environment it is, so it doesn't wait for the next merge request event.
+// Contours created before branches were stored are only updated by merge request events
+func addDeployedEphemeralServices(ctx context.Context, event *gitlab.DeploymentEvent) error {
+	rules, err := initEphemeralRepo(ctx).FindRules(ctx, int64(event.Project.ID))
+	if err != nil {
+		return err
+	}
+	log := logger.GetServerLogger()
+	for _, rule := range rules {
+		git, err := initGitlab(ctx, rule.AppID)
+		if err != nil {
+			log.Errorf("skipping the application %s: %v", rule.AppID, err)
+			continue
+		}
+		if !sameInstance(git, event.Project.WebURL) {
+			continue
+		}
+		existing, err := initEphemeralRepo(ctx).ListContours(ctx, rule.AppID)
+		if err != nil {
+			return err
+		}
+		for _, contour := range existing {
+			if contour.Branch == "" || appsService.EnvironmentName(rule.EnvironmentPattern, contour.Branch, contour.IID) != event.Environment {
+				continue
+			}
+			if err := addEphemeralServices(ctx, git, rule, contour); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addEphemeralServices of rule projects that have the environment of the merge request
+// and aren't in the contour yet
+func addEphemeralServices(ctx context.Context, git *gitlabClient.Client, rule *ephemeralRepo.Rule, contour *ephemeralRepo.Contour) error {
+	info, err := contoursService.Get(ctx, &contours.ContourId{Id: contour.ContourID})
+	if err != nil {
+		return err
+	}
+	added := map[string]bool{}
+	for _, service := range info.GetServices() {
+		added[fmt.Sprintf("%d/%d", service.GetProject(), service.GetEnvironment())] = true
+	}
+	envName := appsService.EnvironmentName(rule.EnvironmentPattern, contour.Branch, contour.IID)
+	var missing []*contours.ServiceWithoutId
+	for _, project := range rule.Projects {
+		env, err := git.FindEnvironment(project, envName)
+		if status.Code(err) == codes.NotFound {
+			// Not deployed yet, it's added by one of the next events
+			continue
+		} else if err != nil {
+			return err
+		}
+		if added[fmt.Sprintf("%d/%d", project, env.ID)] {
+			continue
+		}
+		missing = append(missing, &contours.ServiceWithoutId{
+			Project:         project,
+			Environment:     int64(env.ID),
+			EnvironmentName: env.Name,
+		})
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	_, err = contoursService.AddServices(ctx, &contours.RepeatedServiceWithoutId{
+		ContourId: contour.ContourID,
+		Services:  missing,
+		AppId:     rule.AppID,
+	})
+	return err
+}
+
+// createEphemeralContour named after the merge request. Returns nil if a concurrent
+// delivery of the same event has created one first
+func createEphemeralContour(ctx context.Context, rule *ephemeralRepo.Rule, event *gitlab.MergeEvent) (*ephemeralRepo.Contour, error) {
+	log := logger.GetServerLogger()
+	name := fmt.Sprintf("%s!%d", event.Project.PathWithNamespace, event.ObjectAttributes.IID)
+	created, err := contoursService.Create(ctx, &contours.ContourNameAndDescription{
+		Name:        name,
+		Description: event.ObjectAttributes.Title,
+		AppId:       rule.AppID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	contour := &ephemeralRepo.Contour{
+		AppID:       rule.AppID,
+		Project:     int64(event.Project.ID),
+		IID:         int64(event.ObjectAttributes.IID),
+		ContourID:   created.GetId(),
+		ContourName: name,
+		Branch:      event.ObjectAttributes.SourceBranch,
+	}
+	err = initEphemeralRepo(ctx).AddContour(ctx, contour)
+	if status.Code(err) == codes.AlreadyExists {
+		_, err = contoursService.Delete(ctx, &contours.ContourIdAndName{Id: created.GetId(), Name: name, AppId: rule.AppID})
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+	log.Infof("contour %s is created for the merge request %s", created.GetId(), name)
+	return contour, nil
+}
+
+// deleteEphemeralContours of a merge request in all applications connected to the gitlab
+// that sent the hook, merge requests of other instances may have the same project and iid
+func deleteEphemeralContours(ctx context.Context, project, iid int64, projectURL string) error {
+	log := logger.GetServerLogger()
+	existing, err := initEphemeralRepo(ctx).FindContours(ctx, project, iid)
+	if err != nil {
+		return err
+	}
+	for _, contour := range existing {
+		git, err := initGitlab(ctx, contour.AppID)
+		if err != nil {
+			log.Errorf("skipping the application %s: %v", contour.AppID, err)
+			continue
+		}
+		if !sameInstance(git, projectURL) {
+			continue
+		}
+		_, err = contoursService.Delete(ctx, &contours.ContourIdAndName{
+			Id:    contour.ContourID,
+			Name:  contour.ContourName,
+			AppId: contour.AppID,
+		})
+		if status.Code(err) == codes.FailedPrecondition {
+			// locked contours are kept until someone unlocks them
+			log.Warnf("contour %s of the merge request %s isn't deleted: %v", contour.ContourID, contour.ContourName, err)
+			continue
+		}
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		log.Infof("contour %s of the merge request %s is deleted", contour.ContourID, contour.ContourName)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/spf13/viper"
+	"github.com/xanzy/go-gitlab"
+)
+
+const (
+	gitlabTokenHeader = "X-Gitlab-Token"
+	maxPayloadSize    = 1 << 20
+)
+
+// Handler serves inbound gitlab webhooks
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/webhooks/gitlab", handleGitlab)
+	return mux
+}
+
+func handleGitlab(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetServerLogger()
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !validToken(r.Header.Get(gitlabTokenHeader)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	event, err := gitlab.ParseWebhook(gitlab.HookEventType(r), payload)
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	switch event := event.(type) {
+	case *gitlab.DeploymentEvent:
+		deployment := &DeploymentEvent{}
+		if err = json.Unmarshal(payload, deployment); err == nil {
+			err = HandleDeployment(r.Context(), deployment)
+		}
+	case *gitlab.PipelineEvent:
+		err = HandlePipeline(r.Context(), event)
+	case *gitlab.MergeEvent:
+		err = HandleMergeRequest(r.Context(), event)
+	default:
+		log.Infof("ignoring gitlab event: %s", gitlab.HookEventType(r))
+	}
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validToken compares the token with the configured secret, an empty secret rejects everything
+func validToken(token string) bool {
+	secret := viper.GetString("gitlab_webhook_token")
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
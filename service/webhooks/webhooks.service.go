@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	appsService "github.com/badhouseplants/envspotting-apps/service/applications"
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var initRepo = func(ctx context.Context) repo.DeploymentStateStore {
+	return repo.DeploymentStateRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+var initGitlab = func(ctx context.Context, appID string) (*gitlabClient.Client, error) {
+	return appsService.GitlabClient(ctx, &applications.AppId{Id: appID})
+}
+
+// DeploymentEvent of gitlab together with the deployment id, which go-gitlab doesn't decode
+type DeploymentEvent struct {
+	gitlab.DeploymentEvent
+	DeploymentID int64 `json:"deployment_id"`
+}
+
+// HandleDeployment stores the deployment state for every contour service
+// that points to the deployed environment
+func HandleDeployment(ctx context.Context, event *DeploymentEvent) error {
+	// Services of merge request contours are added first to get the state right away
+	if err := addDeployedEphemeralServices(ctx, &event.DeploymentEvent); err != nil {
+		return err
+	}
+	servicesByApp, err := gitlabServicesByApp(ctx, int64(event.Project.ID))
+	if err != nil {
+		return err
+	}
+	for appID, services := range servicesByApp {
+		if err := handleAppDeployment(ctx, appID, services, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gitlabServicesByApp finds gitlab services of all contours that point to the project.
+// Project ids are only unique within one gitlab instance, so services
+// are grouped to be resolved against the gitlab of their applications
+func gitlabServicesByApp(ctx context.Context, project int64) (map[string][]*repo.ContourService, error) {
+	services, err := initRepo(ctx).FindServices(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	servicesByApp := map[string][]*repo.ContourService{}
+	for _, service := range services {
+		// Only gitlab sends these hooks, github services with the same id are unrelated
+		if service.Provider != "" && service.Provider != scm.ProviderGitlab {
+			continue
+		}
+		servicesByApp[service.AppID] = append(servicesByApp[service.AppID], service)
+	}
+	return servicesByApp, nil
+}
+
+func handleAppDeployment(ctx context.Context, appID string, services []*repo.ContourService, event *DeploymentEvent) error {
+	log := logger.GetServerLogger()
+	project := int64(event.Project.ID)
+	// One application with a broken connection shouldn't hide the deployment from the others
+	git, err := initGitlab(ctx, appID)
+	if err != nil {
+		log.Errorf("skipping the application %s: %v", appID, err)
+		return nil
+	}
+	if !sameInstance(git, event.Project.WebURL) {
+		return nil
+	}
+	// Services added before environment names were stored only have ids,
+	// the environment is looked up once for them
+	var envID int64
+	for _, service := range services {
+		if name := service.Service.GetEnvironmentName(); name != "" {
+			if name != event.Environment {
+				continue
+			}
+		} else {
+			if envID == 0 {
+				env, err := git.FindEnvironment(project, event.Environment)
+				if status.Code(err) == codes.NotFound {
+					log.Infof("environment %s of the project %d is gone, skipping", event.Environment, project)
+					return nil
+				} else if err != nil {
+					return err
+				}
+				envID = int64(env.ID)
+			}
+			if service.Service.GetEnvironment() != envID {
+				continue
+			}
+		}
+		if err := initRepo(ctx).Upsert(ctx, deploymentState(service, event, time.Now())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deploymentState of a service from a deployment hook. Hooks carry neither the ref
+// nor the finish time, so the ref is left empty and the time of a finished
+// deployment is the time the hook has arrived
+func deploymentState(service *repo.ContourService, event *DeploymentEvent, now time.Time) *repo.DeploymentState {
+	state := &repo.DeploymentState{
+		ContourID:     service.ContourID,
+		ServiceID:     service.Service.GetId(),
+		Project:       service.Service.GetProject(),
+		Environment:   service.Service.GetEnvironment(),
+		DeploymentID:  event.DeploymentID,
+		SHA:           commitSHA(&event.DeploymentEvent),
+		Status:        event.Status,
+		CommitTitle:   event.CommitTitle,
+		DeployableURL: event.DeployableURL,
+		UpdatedAt:     now,
+	}
+	switch event.Status {
+	case "success", "failed", "canceled":
+		state.FinishedAt = &now
+	}
+	if event.User != nil {
+		state.Deployer = event.User.Username
+	}
+	return state
+}
+
+// commitSHA returns the full sha of the deployed commit, which is the last part of the commit url.
+// The short sha is used if the url doesn't end with it
+func commitSHA(event *gitlab.DeploymentEvent) string {
+	sha := path.Base(event.CommitURL)
+	if event.ShortSHA != "" && strings.HasPrefix(sha, event.ShortSHA) {
+		return sha
+	}
+	return event.ShortSHA
+}
+
+// sameInstance checks that the project url belongs to the gitlab instance of the client
+func sameInstance(git *gitlabClient.Client, projectURL string) bool {
+	u, err := url.Parse(projectURL)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, git.BaseURL().Host)
+}
+
+// HandlePipeline updates the pipeline status of services deployed from the pipeline commit
+// in applications connected to the gitlab that sent the hook
+func HandlePipeline(ctx context.Context, event *gitlab.PipelineEvent) error {
+	servicesByApp, err := gitlabServicesByApp(ctx, int64(event.Project.ID))
+	if err != nil {
+		return err
+	}
+	log := logger.GetServerLogger()
+	for appID, services := range servicesByApp {
+		git, err := initGitlab(ctx, appID)
+		if err != nil {
+			log.Errorf("skipping the application %s: %v", appID, err)
+			continue
+		}
+		if !sameInstance(git, event.Project.WebURL) {
+			continue
+		}
+		for _, service := range services {
+			err := initRepo(ctx).UpdatePipeline(
+				ctx,
+				service.ContourID,
+				service.Service.GetId(),
+				event.ObjectAttributes.SHA,
+				int64(event.ObjectAttributes.ID),
+				event.ObjectAttributes.Status,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
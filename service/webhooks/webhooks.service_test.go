@@ -0,0 +1,122 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestCommitSHA(t *testing.T) {
+	tests := []struct {
+		name      string
+		shortSHA  string
+		commitURL string
+		want      string
+	}{
+		{
+			name:      "full sha from the commit url",
+			shortSHA:  "279484c0",
+			commitURL: "https://gitlab.com/group/project/-/commit/279484c09fbe69ededfced8c1bb6e6d24616b468",
+			want:      "279484c09fbe69ededfced8c1bb6e6d24616b468",
+		},
+		{
+			name:      "url of another commit",
+			shortSHA:  "279484c0",
+			commitURL: "https://gitlab.com/group/project/-/commit/0123456789abcdef",
+			want:      "279484c0",
+		},
+		{
+			name:     "no commit url",
+			shortSHA: "279484c0",
+			want:     "279484c0",
+		},
+		{
+			name:      "no short sha",
+			commitURL: "https://gitlab.com/group/project/-/commit/279484c09fbe69ededfced8c1bb6e6d24616b468",
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &gitlab.DeploymentEvent{ShortSHA: tt.shortSHA, CommitURL: tt.commitURL}
+			if got := commitSHA(event); got != tt.want {
+				t.Errorf("commitSHA() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeploymentState(t *testing.T) {
+	now := time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)
+	service := &repo.ContourService{
+		AppID:     "app",
+		ContourID: "contour",
+		Service:   &contours.ServiceInfo{Id: "service", Project: 1, Environment: 10, EnvironmentName: "staging"},
+	}
+	tests := []struct {
+		status   string
+		finished bool
+	}{
+		{status: "created"},
+		{status: "running"},
+		{status: "success", finished: true},
+		{status: "failed", finished: true},
+		{status: "canceled", finished: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			event := &DeploymentEvent{
+				DeploymentEvent: gitlab.DeploymentEvent{
+					Status:        tt.status,
+					Environment:   "staging",
+					ShortSHA:      "279484c0",
+					CommitURL:     "https://gitlab.com/group/project/-/commit/279484c09fbe69ededfced8c1bb6e6d24616b468",
+					CommitTitle:   "Fix the build",
+					DeployableURL: "https://gitlab.com/group/project/-/jobs/42",
+					User:          &gitlab.EventUser{Username: "deployer"},
+				},
+				DeploymentID: 42,
+			}
+			state := deploymentState(service, event, now)
+			want := repo.DeploymentState{
+				ContourID:     "contour",
+				ServiceID:     "service",
+				Project:       1,
+				Environment:   10,
+				DeploymentID:  42,
+				SHA:           "279484c09fbe69ededfced8c1bb6e6d24616b468",
+				Status:        tt.status,
+				Deployer:      "deployer",
+				CommitTitle:   "Fix the build",
+				DeployableURL: "https://gitlab.com/group/project/-/jobs/42",
+				UpdatedAt:     now,
+			}
+			if tt.finished {
+				want.FinishedAt = &now
+			}
+			if (state.FinishedAt == nil) != (want.FinishedAt == nil) ||
+				(state.FinishedAt != nil && !state.FinishedAt.Equal(*want.FinishedAt)) {
+				t.Errorf("FinishedAt = %v, want %v", state.FinishedAt, want.FinishedAt)
+			}
+			state.FinishedAt, want.FinishedAt = nil, nil
+			if *state != want {
+				t.Errorf("deploymentState() = %+v, want %+v", *state, want)
+			}
+		})
+	}
+}
+
+func TestDeploymentEventID(t *testing.T) {
+	payload := []byte(`{"object_kind":"deployment","status":"success","deployment_id":15,"environment":"staging","project":{"id":1}}`)
+	event := &DeploymentEvent{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		t.Fatal(err)
+	}
+	if event.DeploymentID != 15 || event.Project.ID != 1 || event.Environment != "staging" {
+		t.Errorf("event = %+v", event)
+	}
+}
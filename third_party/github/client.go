@@ -0,0 +1,237 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultURL = "https://api.github.com/"
+	// perPage is the biggest page size github allows
+	perPage = 100
+)
+
+var nextPageRegexp = regexp.MustCompile(`[?&]page=(\d+)[^>]*>;\s*rel="next"`)
+
+// Connection to github or a github enterprise instance
+type Connection struct {
+	// URL of the api, api.github.com is used if empty
+	URL   string
+	Token string
+}
+
+// Client of the github rest api
+type Client struct {
+	baseURL *url.URL
+	token   string
+	http    *http.Client
+}
+
+// NewClient for the github api of the connection
+func NewClient(conn *Connection) (*Client, error) {
+	rawURL := conn.URL
+	if rawURL == "" {
+		rawURL = defaultURL
+	}
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if baseURL.Path == "" || baseURL.Path[len(baseURL.Path)-1] != '/' {
+		baseURL.Path += "/"
+	}
+	return &Client{
+		baseURL: baseURL,
+		token:   conn.Token,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// BaseURL of the api
+func (c *Client) BaseURL() *url.URL {
+	return c.baseURL
+}
+
+// Repository on github
+type Repository struct {
+	ID            int64  `json:"id"`
+	FullName      string `json:"full_name"`
+	DefaultBranch string `json:"default_branch"`
+	HTMLURL       string `json:"html_url"`
+}
+
+// Environment of a repository
+type Environment struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	HTMLURL string `json:"html_url"`
+}
+
+// User on github
+type User struct {
+	Login string `json:"login"`
+}
+
+// Deployment of a repository
+type Deployment struct {
+	ID          int64      `json:"id"`
+	SHA         string     `json:"sha"`
+	Ref         string     `json:"ref"`
+	Environment string     `json:"environment"`
+	Creator     *User      `json:"creator"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+// Commit of a repository
+type Commit struct {
+	SHA string `json:"sha"`
+}
+
+// DeploymentStatus is one state a deployment went through
+type DeploymentStatus struct {
+	State          string     `json:"state"`
+	EnvironmentURL string     `json:"environment_url"`
+	CreatedAt      *time.Time `json:"created_at"`
+}
+
+// CurrentUser returns the user owning the token
+func (c *Client) CurrentUser(ctx context.Context) (*User, error) {
+	user := &User{}
+	_, err := c.get(ctx, "user", nil, user)
+	return user, err
+}
+
+// GetRepository by its numeric id
+func (c *Client) GetRepository(ctx context.Context, id int64) (*Repository, error) {
+	repo := &Repository{}
+	_, err := c.get(ctx, fmt.Sprintf("repositories/%d", id), nil, repo)
+	return repo, err
+}
+
+// ListEnvironments of a repository
+func (c *Client) ListEnvironments(ctx context.Context, fullName string) ([]*Environment, error) {
+	var envs []*Environment
+	query := url.Values{"per_page": {strconv.Itoa(perPage)}, "page": {"1"}}
+	for {
+		page := &struct {
+			Environments []*Environment `json:"environments"`
+		}{}
+		next, err := c.get(ctx, fmt.Sprintf("repos/%s/environments", fullName), query, page)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, page.Environments...)
+		if next == 0 {
+			return envs, nil
+		}
+		query.Set("page", strconv.Itoa(next))
+	}
+}
+
+// CreateEnvironment of a repository, an existing one is returned unchanged
+func (c *Client) CreateEnvironment(ctx context.Context, fullName, name string) (*Environment, error) {
+	env := &Environment{}
+	_, err := c.do(ctx, http.MethodPut, fmt.Sprintf("repos/%s/environments/%s", fullName, url.PathEscape(name)), nil, env)
+	return env, err
+}
+
+// ListDeployments to an environment newest first, returns the next page number or 0 on the last page
+func (c *Client) ListDeployments(ctx context.Context, fullName, environment string, page, size int) ([]*Deployment, int, error) {
+	var deployments []*Deployment
+	query := url.Values{
+		"environment": {environment},
+		"per_page":    {strconv.Itoa(size)},
+		"page":        {strconv.Itoa(page)},
+	}
+	next, err := c.get(ctx, fmt.Sprintf("repos/%s/deployments", fullName), query, &deployments)
+	return deployments, next, err
+}
+
+// LatestDeploymentStatus of a deployment, nil if it has none
+func (c *Client) LatestDeploymentStatus(ctx context.Context, fullName string, deployment int64) (*DeploymentStatus, error) {
+	var statuses []*DeploymentStatus
+	query := url.Values{"per_page": {"1"}}
+	_, err := c.get(ctx, fmt.Sprintf("repos/%s/deployments/%d/statuses", fullName, deployment), query, &statuses)
+	if err != nil || len(statuses) == 0 {
+		return nil, err
+	}
+	return statuses[0], nil
+}
+
+// GetCommit a tag, a branch or a sha points to
+func (c *Client) GetCommit(ctx context.Context, fullName, ref string) (*Commit, error) {
+	commit := &Commit{}
+	_, err := c.get(ctx, fmt.Sprintf("repos/%s/commits/%s", fullName, url.PathEscape(ref)), nil, commit)
+	return commit, err
+}
+
+// get decodes the response into out and returns the next page number from the Link header
+func (c *Client) get(ctx context.Context, path string, query url.Values, out interface{}) (int, error) {
+	return c.do(ctx, http.MethodGet, path, query, out)
+}
+
+// do sends a request without a body and decodes the response into out
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, out interface{}) (int, error) {
+	u, err := c.baseURL.Parse(path)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, status.Error(codes.Unavailable, err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return 0, statusError(resp.StatusCode, string(body))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	return nextPage(resp.Header.Get("Link")), nil
+}
+
+func nextPage(link string) int {
+	match := nextPageRegexp.FindStringSubmatch(link)
+	if match == nil {
+		return 0
+	}
+	page, _ := strconv.Atoi(match[1])
+	return page
+}
+
+// statusError converts a github api error into a grpc status error
+func statusError(code int, message string) error {
+	switch code {
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, message)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, message)
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return status.Error(codes.InvalidArgument, message)
+	case http.StatusTooManyRequests:
+		return status.Error(codes.ResourceExhausted, message)
+	default:
+		return status.Error(codes.Unavailable, message)
+	}
+}
@@ -1,14 +1,101 @@
 package gitlab
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/url"
+
 	"github.com/xanzy/go-gitlab"
 )
 
-func Client(token string) (*gitlab.Client, error) {
-	// git, err := gitlab.NewClient(viper.GetString("gitlab_token"))
-	git, err := gitlab.NewClient(token)
+// perPage is the biggest page size gitlab allows
+const perPage = 100
+
+var errInvalidCABundle = errors.New("ca bundle doesn't contain any valid certificate")
+
+// Connection to a gitlab instance
+type Connection struct {
+	// URL of a self-hosted instance, gitlab.com is used if empty
+	URL   string
+	Token string
+	// CABundle is a PEM encoded bundle trusted in addition to the system pool
+	CABundle string
+}
+
+// Client of a gitlab instance, its methods return grpc status errors
+type Client struct {
+	git *gitlab.Client
+	// ctx cancels requests and retries, it's set by WithContext
+	ctx context.Context
+}
+
+// NewClient for the gitlab instance of the connection. Requests limited by gitlab or failed
+// with a server error are retried, GET responses are revalidated with their ETags
+func NewClient(conn *Connection) (*Client, error) {
+	httpClient := &http.Client{Transport: http.DefaultTransport}
+	if conn.CABundle != "" {
+		var err error
+		if httpClient, err = httpClientWithCA(conn.CABundle); err != nil {
+			return nil, err
+		}
+	}
+	httpClient.Transport = newTransport(httpClient.Transport)
+	opts := []gitlab.ClientOptionFunc{
+		gitlab.WithHTTPClient(httpClient),
+		gitlab.WithCustomRetry(checkRetry),
+		gitlab.WithCustomBackoff(backoff),
+	}
+	if conn.URL != "" {
+		opts = append(opts, gitlab.WithBaseURL(conn.URL))
+	}
+	git, err := gitlab.NewClient(conn.Token, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return git, nil
+	return &Client{git: git}, nil
+}
+
+// WithContext returns a copy of the client whose requests and retries end with the context
+func (c *Client) WithContext(ctx context.Context) *Client {
+	copied := *c
+	copied.ctx = ctx
+	return &copied
+}
+
+// options of every request, extra ones are added to the context
+func (c *Client) options(extra ...gitlab.RequestOptionFunc) []gitlab.RequestOptionFunc {
+	if c.ctx == nil {
+		return extra
+	}
+	return append([]gitlab.RequestOptionFunc{gitlab.WithContext(c.ctx)}, extra...)
+}
+
+// BaseURL of the gitlab api
+func (c *Client) BaseURL() *url.URL {
+	return c.git.BaseURL()
+}
+
+// CurrentUser returns the user owning the token, it's the cheapest way to test a connection
+func (c *Client) CurrentUser() (*gitlab.User, error) {
+	user, _, err := c.git.Users.CurrentUser(c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return user, nil
+}
+
+func httpClientWithCA(bundle string) (*http.Client, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(bundle)) {
+		return nil, errInvalidCABundle
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return &http.Client{Transport: transport}, nil
 }
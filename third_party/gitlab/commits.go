@@ -0,0 +1,14 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// GetCommit a tag, a branch or a sha points to
+func (c *Client) GetCommit(project int64, ref string) (*gitlab.Commit, error) {
+	commit, _, err := c.git.Commits.GetCommit(int(project), ref, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return commit, nil
+}
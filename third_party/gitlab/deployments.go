@@ -0,0 +1,65 @@
+package gitlab
+
+import (
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LastSuccessfulDeployment to the environment
+func (c *Client) LastSuccessfulDeployment(project int64, environment string) (*gitlab.Deployment, error) {
+	deployments, _, err := c.git.Deployments.ListProjectDeployments(int(project), &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 1},
+		Environment: gitlab.String(environment),
+		Status:      gitlab.String("success"),
+		OrderBy:     gitlab.String("id"),
+		Sort:        gitlab.String("desc"),
+	}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	if len(deployments) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("environment %s of the project %d has no successful deployments", environment, project))
+	}
+	return deployments[0], nil
+}
+
+// SuccessfulDeploymentBefore returns the newest successful deployment to the environment
+// that is older than the given one and deployed another commit
+func (c *Client) SuccessfulDeploymentBefore(project int64, environment string, live *gitlab.Deployment) (*gitlab.Deployment, error) {
+	opts := &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: perPage, Page: 1},
+		Environment: gitlab.String(environment),
+		Status:      gitlab.String("success"),
+		OrderBy:     gitlab.String("id"),
+		Sort:        gitlab.String("desc"),
+	}
+	for {
+		deployments, next, err := c.ListDeployments(project, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, deployment := range deployments {
+			// Redeploys of the live commit wouldn't change anything
+			if deployment.ID < live.ID && deployment.SHA != live.SHA {
+				return deployment, nil
+			}
+		}
+		if next == 0 {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("environment %s of the project %d has no successful deployments of another commit before %d", environment, project, live.ID))
+		}
+		opts.Page = next
+	}
+}
+
+// ListDeployments returns one page of project deployments and the number of the next one,
+// which is 0 on the last page
+func (c *Client) ListDeployments(project int64, opts *gitlab.ListProjectDeploymentsOptions) ([]*gitlab.Deployment, int, error) {
+	deployments, resp, err := c.git.Deployments.ListProjectDeployments(int(project), opts, c.options()...)
+	if err != nil {
+		return nil, 0, StatusError(err)
+	}
+	return deployments, resp.NextPage, nil
+}
@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSuccessfulDeploymentBefore(t *testing.T) {
+	tests := []struct {
+		name        string
+		deployments string
+		want        int
+		code        codes.Code
+	}{
+		{
+			name:        "previous commit",
+			deployments: `[{"id":3,"sha":"c"},{"id":2,"sha":"b"},{"id":1,"sha":"a"}]`,
+			want:        2,
+		},
+		{
+			name:        "redeploys of the live commit are skipped",
+			deployments: `[{"id":3,"sha":"c"},{"id":2,"sha":"c"},{"id":1,"sha":"a"}]`,
+			want:        1,
+		},
+		{
+			name:        "only the live commit",
+			deployments: `[{"id":3,"sha":"c"},{"id":2,"sha":"c"}]`,
+			code:        codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/api/v4/" {
+					return
+				}
+				w.Write([]byte(tt.deployments))
+			}))
+			defer server.Close()
+			git, err := NewClient(&Connection{URL: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := git.SuccessfulDeploymentBefore(1, "staging", &gitlab.Deployment{ID: 3, SHA: "c"})
+			if status.Code(err) != tt.code {
+				t.Fatalf("error = %v, want %v", err, tt.code)
+			}
+			if err == nil && got.ID != tt.want {
+				t.Errorf("deployment = %d, want %d", got.ID, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetEnvironment returns a project environment with its last deployment
+func (c *Client) GetEnvironment(project, environment int64) (*gitlab.Environment, error) {
+	env, _, err := c.git.Environments.GetEnvironment(int(project), int(environment), c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return env, nil
+}
+
+// FindEnvironment of a project by its name
+func (c *Client) FindEnvironment(project int64, name string) (*gitlab.Environment, error) {
+	envs, _, err := c.git.Environments.ListEnvironments(int(project), &gitlab.ListEnvironmentsOptions{
+		Name: gitlab.String(name),
+	}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	for _, env := range envs {
+		if env.Name == name {
+			return env, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, fmt.Sprintf("environment %s can't be found in the project %d", name, project))
+}
+
+// ListEnvironments returns all environments of a project
+func (c *Client) ListEnvironments(project int64) ([]*gitlab.Environment, error) {
+	var envs []*gitlab.Environment
+	opts := &gitlab.ListEnvironmentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: perPage},
+	}
+	err := paginate(&opts.ListOptions, func() (*gitlab.Response, error) {
+		page, resp, err := c.git.Environments.ListEnvironments(int(project), opts, c.options()...)
+		envs = append(envs, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return envs, nil
+}
+
+// CreateEnvironment in a project
+func (c *Client) CreateEnvironment(project int64, name string) (*gitlab.Environment, error) {
+	env, _, err := c.git.Environments.CreateEnvironment(int(project), &gitlab.CreateEnvironmentOptions{
+		Name: gitlab.String(name),
+	}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return env, nil
+}
+
+// StopEnvironment runs the stop action of an environment
+func (c *Client) StopEnvironment(project, environment int64) error {
+	_, err := c.git.Environments.StopEnvironment(int(project), int(environment), c.options()...)
+	if err != nil {
+		return StatusError(err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package gitlab
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusError converts a gitlab api error into a grpc status error
+func StatusError(err error) error {
+	var glErr *gitlab.ErrorResponse
+	if !errors.As(err, &glErr) || glErr.Response == nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	switch glErr.Response.StatusCode {
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, glErr.Message)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, glErr.Message)
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return status.Error(codes.InvalidArgument, glErr.Message)
+	case http.StatusTooManyRequests:
+		return status.Error(codes.ResourceExhausted, glErr.Message)
+	default:
+		return status.Error(codes.Unavailable, glErr.Message)
+	}
+}
@@ -0,0 +1,25 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// ListGroupProjects returns all projects of a group and its subgroups
+func (c *Client) ListGroupProjects(group string) ([]*gitlab.Project, error) {
+	var projects []*gitlab.Project
+	opts := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: perPage},
+		IncludeSubgroups: gitlab.Bool(true),
+		Archived:         gitlab.Bool(false),
+		Simple:           gitlab.Bool(true),
+	}
+	err := paginate(&opts.ListOptions, func() (*gitlab.Response, error) {
+		page, resp, err := c.git.Groups.ListGroupProjects(group, opts, c.options()...)
+		projects = append(projects, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
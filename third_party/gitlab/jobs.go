@@ -0,0 +1,14 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// RetryJob creates a new run of a job
+func (c *Client) RetryJob(project int64, job int) (*gitlab.Job, error) {
+	retried, _, err := c.git.Jobs.RetryJob(int(project), job, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return retried, nil
+}
@@ -0,0 +1,38 @@
+package gitlab
+
+import (
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// ListMergedMergeRequests into the branch updated after the time
+func (c *Client) ListMergedMergeRequests(project int64, branch string, updatedAfter *time.Time) ([]*gitlab.MergeRequest, error) {
+	var mrs []*gitlab.MergeRequest
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: perPage},
+		State:        gitlab.String("merged"),
+		TargetBranch: gitlab.String(branch),
+		UpdatedAfter: updatedAfter,
+		OrderBy:      gitlab.String("updated_at"),
+		Sort:         gitlab.String("desc"),
+	}
+	err := paginate(&opts.ListOptions, func() (*gitlab.Response, error) {
+		page, resp, err := c.git.MergeRequests.ListProjectMergeRequests(int(project), opts, c.options()...)
+		mrs = append(mrs, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mrs, nil
+}
+
+// GetMergeRequest by its iid in the project
+func (c *Client) GetMergeRequest(project, iid int64) (*gitlab.MergeRequest, error) {
+	mr, _, err := c.git.MergeRequests.GetMergeRequest(int(project), int(iid), &gitlab.GetMergeRequestsOptions{}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return mr, nil
+}
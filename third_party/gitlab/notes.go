@@ -0,0 +1,36 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// CreateMergeRequestNote posts a comment on a merge request
+func (c *Client) CreateMergeRequestNote(project, iid int64, body string) (*gitlab.Note, error) {
+	note, _, err := c.git.Notes.CreateMergeRequestNote(int(project), int(iid), &gitlab.CreateMergeRequestNoteOptions{
+		Body: gitlab.String(body),
+	}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return note, nil
+}
+
+// UpdateMergeRequestNote replaces the body of a comment on a merge request
+func (c *Client) UpdateMergeRequestNote(project, iid, note int64, body string) (*gitlab.Note, error) {
+	updated, _, err := c.git.Notes.UpdateMergeRequestNote(int(project), int(iid), int(note), &gitlab.UpdateMergeRequestNoteOptions{
+		Body: gitlab.String(body),
+	}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return updated, nil
+}
+
+// DeleteMergeRequestNote removes a comment from a merge request
+func (c *Client) DeleteMergeRequestNote(project, iid, note int64) error {
+	_, err := c.git.Notes.DeleteMergeRequestNote(int(project), int(iid), int(note), c.options()...)
+	if err != nil {
+		return StatusError(err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// paginate calls fetch for every page from the first to the last one,
+// fetch is expected to read the page set in opts
+func paginate(opts *gitlab.ListOptions, fetch func() (*gitlab.Response, error)) error {
+	if opts.PerPage == 0 {
+		opts.PerPage = perPage
+	}
+	if opts.Page == 0 {
+		opts.Page = 1
+	}
+	for {
+		resp, err := fetch()
+		if err != nil {
+			return StatusError(err)
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
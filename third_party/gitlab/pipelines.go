@@ -0,0 +1,24 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// CreatePipeline runs a new pipeline on the ref with extra variables
+func (c *Client) CreatePipeline(project int64, ref string, variables map[string]string) (*gitlab.Pipeline, error) {
+	opts := &gitlab.CreatePipelineOptions{
+		Ref: gitlab.String(ref),
+	}
+	for key, value := range variables {
+		opts.Variables = append(opts.Variables, &gitlab.PipelineVariable{
+			Key:          key,
+			Value:        value,
+			VariableType: "env_var",
+		})
+	}
+	pipeline, _, err := c.git.Pipelines.CreatePipeline(int(project), opts, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return pipeline, nil
+}
@@ -0,0 +1,23 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// GetProject by its gitlab id
+func (c *Client) GetProject(project int64) (*gitlab.Project, error) {
+	proj, _, err := c.git.Projects.GetProject(int(project), &gitlab.GetProjectOptions{}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return proj, nil
+}
+
+// FindProject by its path with namespace
+func (c *Client) FindProject(path string) (*gitlab.Project, error) {
+	proj, _, err := c.git.Projects.GetProject(path, &gitlab.GetProjectOptions{}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return proj, nil
+}
@@ -0,0 +1,35 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// GetProtectedEnvironment returns NotFound if the environment isn't protected
+func (c *Client) GetProtectedEnvironment(project int64, environment string) (*gitlab.ProtectedEnvironment, error) {
+	protected, _, err := c.git.ProtectedEnvironments.GetProtectedEnvironment(int(project), environment, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return protected, nil
+}
+
+// ProtectEnvironment so only the listed users, groups or access levels can deploy to it
+func (c *Client) ProtectEnvironment(project int64, environment string, access []*gitlab.EnvironmentAccessOptions) (*gitlab.ProtectedEnvironment, error) {
+	protected, _, err := c.git.ProtectedEnvironments.ProtectRepositoryEnvironments(int(project), &gitlab.ProtectRepositoryEnvironmentsOptions{
+		Name:               gitlab.String(environment),
+		DeployAccessLevels: access,
+	}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return protected, nil
+}
+
+// UnprotectEnvironment so anyone who can run pipelines can deploy to it
+func (c *Client) UnprotectEnvironment(project int64, environment string) error {
+	_, err := c.git.ProtectedEnvironments.UnprotectEnvironment(int(project), environment, c.options()...)
+	if err != nil {
+		return StatusError(err)
+	}
+	return nil
+}
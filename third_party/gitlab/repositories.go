@@ -0,0 +1,17 @@
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+// Compare two refs of a project, commits are the ones reachable from `to` but not from `from`
+func (c *Client) Compare(project int64, from, to string) (*gitlab.Compare, error) {
+	compare, _, err := c.git.Repositories.Compare(int(project), &gitlab.CompareOptions{
+		From: gitlab.String(from),
+		To:   gitlab.String(to),
+	}, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return compare, nil
+}
@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Bounds of the exponential backoff between retries
+const (
+	retryWaitBase = 500 * time.Millisecond
+	retryWaitMax  = 30 * time.Second
+)
+
+// checkRetry retries requests limited by gitlab and, unless they can't be
+// repeated safely, the ones that failed with a server error
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	retry, checkErr := shouldRetry(ctx, resp, err)
+	if retry {
+		host := ""
+		if resp != nil && resp.Request != nil {
+			host = resp.Request.URL.Host
+		}
+		count(host, func(c *HostCounters) { c.Retries++ })
+	}
+	return retry, checkErr
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if err != nil {
+		// The request may have reached gitlab, it's unknown if it can be repeated
+		return false, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true, nil
+	case resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented:
+		return resp.Request == nil || idempotent(resp.Request.Method), nil
+	}
+	return false, nil
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff waits as long as Retry-After asks, or exponentially with full jitter if it's not set.
+// Neither waits longer than retryWaitMax, or the max of the client if it's bigger
+func backoff(_, max time.Duration, attempt int, resp *http.Response) time.Duration {
+	limit := retryWaitMax
+	if max > limit {
+		limit = max
+	}
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > limit {
+				return limit
+			}
+			return wait
+		}
+	}
+	wait := limit
+	if attempt < 32 && retryWaitBase<<uint(attempt) < limit {
+		wait = retryWaitBase << uint(attempt)
+	}
+	return time.Duration(rand.Int63n(int64(wait) + 1))
+}
+
+// retryAfter parses the header given either in seconds or as a date
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
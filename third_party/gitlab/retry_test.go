@@ -0,0 +1,150 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   int
+		err    error
+		retry  bool
+	}{
+		{name: "ok", method: http.MethodGet, code: http.StatusOK},
+		{name: "not found", method: http.MethodGet, code: http.StatusNotFound},
+		{name: "limited get", method: http.MethodGet, code: http.StatusTooManyRequests, retry: true},
+		{name: "limited post", method: http.MethodPost, code: http.StatusTooManyRequests, retry: true},
+		{name: "server error get", method: http.MethodGet, code: http.StatusBadGateway, retry: true},
+		{name: "server error put", method: http.MethodPut, code: http.StatusInternalServerError, retry: true},
+		{name: "server error post", method: http.MethodPost, code: http.StatusInternalServerError},
+		{name: "not implemented", method: http.MethodGet, code: http.StatusNotImplemented},
+		{name: "network error", method: http.MethodGet, err: errors.New("connection reset")},
+		{name: "canceled", ctx: canceled, method: http.MethodGet, code: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			var resp *http.Response
+			if tt.err == nil {
+				req := httptest.NewRequest(tt.method, "https://gitlab.example.com/api/v4/projects/1", nil)
+				resp = &http.Response{StatusCode: tt.code, Request: req}
+			}
+			retry, _ := shouldRetry(ctx, resp, tt.err)
+			if retry != tt.retry {
+				t.Errorf("shouldRetry = %v, want %v", retry, tt.retry)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		header string
+		wait   time.Duration
+		ok     bool
+	}{
+		{header: ""},
+		{header: "3", wait: 3 * time.Second, ok: true},
+		{header: "0", ok: true},
+		{header: "-1"},
+		{header: "soon"},
+		{header: "Mon, 02 Jan 2006 15:04:05 GMT", ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			wait, ok := retryAfter(tt.header)
+			if wait != tt.wait || ok != tt.ok {
+				t.Errorf("retryAfter(%q) = %s %v, want %s %v", tt.header, wait, ok, tt.wait, tt.ok)
+			}
+		})
+	}
+	future := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if wait, ok := retryAfter(future); !ok || wait <= 59*time.Minute || wait > time.Hour {
+		t.Errorf("retryAfter(%q) = %s %v, want about an hour", future, wait, ok)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if wait := backoff(0, 0, 3, resp); wait != 7*time.Second {
+		t.Errorf("backoff with Retry-After = %s, want 7s", wait)
+	}
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	if wait := backoff(0, 0, 0, resp); wait != retryWaitMax {
+		t.Errorf("backoff with a long Retry-After = %s, want %s", wait, retryWaitMax)
+	}
+	for attempt, max := range map[int]time.Duration{
+		0:  retryWaitBase,
+		2:  4 * retryWaitBase,
+		10: retryWaitMax,
+		40: retryWaitMax,
+	} {
+		for i := 0; i < 100; i++ {
+			if wait := backoff(0, 0, attempt, nil); wait < 0 || wait > max {
+				t.Fatalf("backoff of the attempt %d = %s, want at most %s", attempt, wait, max)
+			}
+		}
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		failures int
+		attempts int
+		ok       bool
+	}{
+		{name: "get is retried", method: http.MethodGet, failures: 2, attempts: 3, ok: true},
+		{name: "post isn't retried", method: http.MethodPost, failures: 1, attempts: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				// go-gitlab probes the base url for rate limits before the first request
+				if r.URL.Path == "/api/v4/" {
+					return
+				}
+				attempts++
+				if attempts <= tt.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusServiceUnavailable)
+					w.Write([]byte(`{"message":"unavailable"}`))
+					return
+				}
+				w.Write([]byte(`{"id":1,"name":"staging"}`))
+			}))
+			defer server.Close()
+			git, err := NewClient(&Connection{URL: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.method == http.MethodGet {
+				_, err = git.GetEnvironment(1, 1)
+			} else {
+				_, err = git.CreateEnvironment(1, "staging")
+			}
+			if (err == nil) != tt.ok {
+				t.Errorf("error = %v, want success %v", err, tt.ok)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
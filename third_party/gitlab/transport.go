@@ -0,0 +1,232 @@
+package gitlab
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"expvar"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// Responses bigger than that are not cached
+const maxCachedBody = 1 << 20
+
+// HostCounters of requests sent to one gitlab host
+type HostCounters struct {
+	// Requests is the number of attempts, retries included
+	Requests int64
+	Retries  int64
+	// CacheHits is the number of GET requests answered with 304 Not Modified
+	CacheHits int64
+	// Errors is the number of attempts that failed with a network error, 429 or 5xx
+	Errors int64
+}
+
+var (
+	countersMu sync.Mutex
+	counters   = map[string]*HostCounters{}
+)
+
+func init() {
+	expvar.Publish("gitlab_requests", expvar.Func(func() interface{} {
+		return RequestCounters()
+	}))
+}
+
+// RequestCounters of every gitlab host requested since the start
+func RequestCounters() map[string]HostCounters {
+	countersMu.Lock()
+	defer countersMu.Unlock()
+	snapshot := make(map[string]HostCounters, len(counters))
+	for host, c := range counters {
+		snapshot[host] = *c
+	}
+	return snapshot
+}
+
+func count(host string, add func(c *HostCounters)) {
+	countersMu.Lock()
+	defer countersMu.Unlock()
+	c, ok := counters[host]
+	if !ok {
+		c = &HostCounters{}
+		counters[host] = c
+	}
+	add(c)
+}
+
+// transport counts requests and makes GET requests conditional
+// on the ETag of the response cached before
+type transport struct {
+	next  http.RoundTripper
+	cache *etagCache
+}
+
+func newTransport(next http.RoundTripper) http.RoundTripper {
+	return &transport{next: next, cache: sharedCache()}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	count(host, func(c *HostCounters) { c.Requests++ })
+	if req.Method != http.MethodGet {
+		return t.send(host, req)
+	}
+	key := cacheKey(req)
+	cached, ok := t.cache.get(key)
+	if ok {
+		// RoundTrip must not modify the request
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+	resp, err := t.send(host, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		count(host, func(c *HostCounters) { c.CacheHits++ })
+		return cached.response(req), nil
+	}
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxCachedBody+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxCachedBody {
+		// The rest of the body is still unread, so it's chained to what was read
+		resp.Body = readCloser{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	t.cache.add(key, &cachedResponse{etag: etag, header: resp.Header.Clone(), body: body})
+	return resp, nil
+}
+
+func (t *transport) send(host string, req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		count(host, func(c *HostCounters) { c.Errors++ })
+	}
+	return resp, err
+}
+
+// cacheKey is unique per url and token, so users never get responses fetched with another token
+func cacheKey(req *http.Request) string {
+	token := req.Header.Get("PRIVATE-TOKEN") + "\n" + req.Header.Get("Authorization") + "\n" + req.Header.Get("JOB-TOKEN")
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:]) + " " + req.URL.String()
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+type cachedResponse struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+var (
+	cache     *etagCache
+	cacheOnce sync.Once
+)
+
+// sharedCache of all clients, it's bounded by gitlab_cache_size responses
+// and by gitlab_cache_max_bytes of their keys and bodies
+func sharedCache() *etagCache {
+	cacheOnce.Do(func() {
+		cache = newEtagCache(viper.GetInt("gitlab_cache_size"), viper.GetInt64("gitlab_cache_max_bytes"))
+	})
+	return cache
+}
+
+// etagCache keeps the last used responses
+type etagCache struct {
+	mu       sync.Mutex
+	size     int
+	maxBytes int64
+	bytes    int64
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+func newEtagCache(size int, maxBytes int64) *etagCache {
+	return &etagCache{
+		size:     size,
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+type cacheEntry struct {
+	key      string
+	response *cachedResponse
+}
+
+// bytes the entry takes, headers are small enough to be left out
+func (e *cacheEntry) bytes() int64 {
+	return int64(len(e.key) + len(e.response.body))
+}
+
+func (c *etagCache) get(key string) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*cacheEntry).response, true
+}
+
+func (c *etagCache) add(key string, response *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &cacheEntry{key: key, response: response}
+	if c.size <= 0 || entry.bytes() > c.maxBytes {
+		return
+	}
+	if element, ok := c.entries[key]; ok {
+		c.bytes -= element.Value.(*cacheEntry).bytes()
+		element.Value = entry
+		c.order.MoveToFront(element)
+	} else {
+		c.entries[key] = c.order.PushFront(entry)
+	}
+	c.bytes += entry.bytes()
+	for c.order.Len() > c.size || c.bytes > c.maxBytes {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.bytes -= oldest.Value.(*cacheEntry).bytes()
+	}
+}
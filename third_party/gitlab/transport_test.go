@@ -0,0 +1,55 @@
+package gitlab
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEtagCacheBounds(t *testing.T) {
+	response := func(size int) *cachedResponse {
+		return &cachedResponse{etag: "etag", body: []byte(strings.Repeat("x", size))}
+	}
+	tests := []struct {
+		name     string
+		size     int
+		maxBytes int64
+		bodies   []int
+		kept     []string
+		bytes    int64
+	}{
+		{name: "fits", size: 10, maxBytes: 100, bodies: []int{10, 10, 10}, kept: []string{"a", "b", "c"}, bytes: 33},
+		{name: "evicted by count", size: 2, maxBytes: 100, bodies: []int{10, 10, 10}, kept: []string{"b", "c"}, bytes: 22},
+		{name: "evicted by bytes", size: 10, maxBytes: 25, bodies: []int{10, 10, 10}, kept: []string{"b", "c"}, bytes: 22},
+		{name: "too big for the cache", size: 10, maxBytes: 25, bodies: []int{10, 30, 10}, kept: []string{"a", "c"}, bytes: 22},
+		{name: "disabled", size: 0, maxBytes: 100, bodies: []int{10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newEtagCache(tt.size, tt.maxBytes)
+			for i, size := range tt.bodies {
+				cache.add(string(rune('a'+i)), response(size))
+			}
+			if len(cache.entries) != len(tt.kept) || cache.bytes != tt.bytes {
+				t.Errorf("cache has %d entries of %d bytes, want %d of %d", len(cache.entries), cache.bytes, len(tt.kept), tt.bytes)
+			}
+			for _, key := range tt.kept {
+				if _, ok := cache.get(key); !ok {
+					t.Errorf("%s isn't cached", key)
+				}
+			}
+		})
+	}
+}
+
+func TestEtagCacheReplace(t *testing.T) {
+	cache := newEtagCache(10, 100)
+	cache.add("a", &cachedResponse{etag: "1", body: []byte("0123456789")})
+	cache.add("a", &cachedResponse{etag: "2", body: []byte("01234")})
+	cached, ok := cache.get("a")
+	if !ok || cached.etag != "2" {
+		t.Fatalf("cached = %+v, want the second response", cached)
+	}
+	if cache.bytes != 6 {
+		t.Errorf("bytes = %d, want 6", cache.bytes)
+	}
+}
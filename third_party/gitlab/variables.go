@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ListVariables of a project in all environment scopes
+func (c *Client) ListVariables(project int64) ([]*gitlab.ProjectVariable, error) {
+	var variables []*gitlab.ProjectVariable
+	opts := &gitlab.ListProjectVariablesOptions{}
+	err := paginate((*gitlab.ListOptions)(opts), func() (*gitlab.Response, error) {
+		page, resp, err := c.git.ProjectVariables.ListVariables(int(project), opts, c.options()...)
+		variables = append(variables, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return variables, nil
+}
+
+// CreateVariable in a project
+func (c *Client) CreateVariable(project int64, opts *gitlab.CreateProjectVariableOptions) (*gitlab.ProjectVariable, error) {
+	variable, _, err := c.git.ProjectVariables.CreateVariable(int(project), opts, c.options()...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return variable, nil
+}
+
+// UpdateVariable of one environment scope
+func (c *Client) UpdateVariable(project int64, key, scope string, opts *gitlab.UpdateProjectVariableOptions) (*gitlab.ProjectVariable, error) {
+	variable, _, err := c.git.ProjectVariables.UpdateVariable(int(project), key, opts, c.options(environmentScope(scope))...)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return variable, nil
+}
+
+// RemoveVariable of one environment scope
+func (c *Client) RemoveVariable(project int64, key, scope string) error {
+	_, err := c.git.ProjectVariables.RemoveVariable(int(project), key, c.options(environmentScope(scope))...)
+	if err != nil {
+		return StatusError(err)
+	}
+	return nil
+}
+
+// environmentScope filters a variable by its scope, otherwise gitlab
+// refuses to touch keys that are defined in several scopes
+func environmentScope(scope string) gitlab.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		query := req.URL.Query()
+		query.Set("filter[environment_scope]", scope)
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
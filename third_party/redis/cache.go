@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/go-redis/redis/v8"
+)
+
+const keyPrefix = "envspotting-apps"
+
+// cacheStats counts hits and misses of every kind of cached values
+var cacheStats = expvar.NewMap("redis_cache")
+
+// Cache of one kind of values, stored as json.
+// Redis errors are only logged, so callers fall back to the database
+type Cache struct {
+	kind string
+	ttl  time.Duration
+}
+
+// NewCache of values of the kind, it's disabled if ttl isn't positive
+func NewCache(kind string, ttl time.Duration) *Cache {
+	return &Cache{kind: kind, ttl: ttl}
+}
+
+// Get the value cached by id into v and return false on a miss
+func (c *Cache) Get(ctx context.Context, id string, v interface{}) bool {
+	if c.ttl <= 0 {
+		return false
+	}
+	data, err := Client().Get(ctx, c.key(id)).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			logger.GetGrpcLogger(ctx).Error(err)
+		}
+		cacheStats.Add(c.kind+"_misses", 1)
+		return false
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+		cacheStats.Add(c.kind+"_misses", 1)
+		return false
+	}
+	cacheStats.Add(c.kind+"_hits", 1)
+	return true
+}
+
+// Set the value cached by id
+func (c *Cache) Set(ctx context.Context, id string, v interface{}) {
+	if c.ttl <= 0 {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+		return
+	}
+	if err := Client().Set(ctx, c.key(id), data, c.ttl).Err(); err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+	}
+}
+
+// Invalidate values cached by ids
+func (c *Cache) Invalidate(ctx context.Context, ids ...string) {
+	if c.ttl <= 0 || len(ids) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, c.key(id))
+	}
+	if err := Client().Del(ctx, keys...).Err(); err != nil {
+		logger.GetGrpcLogger(ctx).Error(err)
+	}
+}
+
+func (c *Cache) key(id string) string {
+	return keyPrefix + ":" + c.kind + ":" + id
+}
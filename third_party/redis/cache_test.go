@@ -0,0 +1,216 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// fakeRedis understands GET, SET and DEL of the resp protocol, values never expire
+type fakeRedis struct {
+	mu       sync.Mutex
+	values   map[string]string
+	ttls     map[string]string
+	commands int
+}
+
+// newFakeRedis serves a fake and points the package client to it
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := &fakeRedis{values: map[string]string{}, ttls: map[string]string{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go fake.serve(conn)
+		}
+	}()
+	useClient(t, listener.Addr().String())
+	t.Cleanup(func() { listener.Close() })
+	return fake
+}
+
+func useClient(t *testing.T, addr string) {
+	clientOnce.Do(func() {})
+	client = redis.NewClient(&redis.Options{Addr: addr, MaxRetries: -1, DialTimeout: time.Second})
+	t.Cleanup(func() { client.Close() })
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		f.mu.Lock()
+		f.commands++
+		var reply string
+		switch strings.ToLower(args[0]) {
+		case "get":
+			if v, ok := f.values[args[1]]; ok {
+				reply = fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+			} else {
+				reply = "$-1\r\n"
+			}
+		case "set":
+			f.values[args[1]] = args[2]
+			f.ttls[args[1]] = strings.ToLower(strings.Join(args[3:], " "))
+			reply = "+OK\r\n"
+		case "del":
+			deleted := 0
+			for _, key := range args[1:] {
+				if _, ok := f.values[key]; ok {
+					delete(f.values, key)
+					deleted++
+				}
+			}
+			reply = fmt.Sprintf(":%d\r\n", deleted)
+		default:
+			reply = "+OK\r\n"
+		}
+		f.mu.Unlock()
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+// readCommand reads an array of bulk strings
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "$")))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+type value struct {
+	Name string
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		invalidate  []string
+		wantCached  []string
+		wantMissing []string
+	}{
+		{name: "nothing", wantCached: []string{"a", "b"}},
+		{name: "one", invalidate: []string{"a"}, wantCached: []string{"b"}, wantMissing: []string{"a"}},
+		{name: "all", invalidate: []string{"a", "b"}, wantMissing: []string{"a", "b"}},
+		{name: "unknown", invalidate: []string{"c"}, wantCached: []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFakeRedis(t)
+			cache := NewCache("applications", time.Minute)
+			cache.Set(ctx, "a", &value{Name: "a"})
+			cache.Set(ctx, "b", &value{Name: "b"})
+			cache.Invalidate(ctx, tt.invalidate...)
+			for _, id := range tt.wantCached {
+				got := &value{}
+				if !cache.Get(ctx, id, got) || got.Name != id {
+					t.Errorf("%s = %+v, want it cached", id, got)
+				}
+			}
+			for _, id := range tt.wantMissing {
+				if cache.Get(ctx, id, &value{}) {
+					t.Errorf("%s is still cached", id)
+				}
+			}
+		})
+	}
+}
+
+func TestCacheKinds(t *testing.T) {
+	ctx := context.Background()
+	newFakeRedis(t)
+	apps, contours := NewCache("applications", time.Minute), NewCache("contours", time.Minute)
+	apps.Set(ctx, "a", &value{Name: "app"})
+	contours.Set(ctx, "a", &value{Name: "contour"})
+	contours.Invalidate(ctx, "a")
+	got := &value{}
+	if !apps.Get(ctx, "a", got) || got.Name != "app" {
+		t.Errorf("application = %+v, want it kept when a contour with the same id is invalidated", got)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	fake := newFakeRedis(t)
+	NewCache("contours", 30*time.Second).Set(context.Background(), "a", &value{})
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if ttl := fake.ttls["envspotting-apps:contours:a"]; ttl != "ex 30" {
+		t.Errorf("expiration = %q, want ex 30", ttl)
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeRedis(t)
+	cache := NewCache("applications", 0)
+	cache.Set(ctx, "a", &value{Name: "a"})
+	if cache.Get(ctx, "a", &value{}) {
+		t.Error("disabled cache returned a value")
+	}
+	cache.Invalidate(ctx, "a")
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.commands != 0 {
+		t.Errorf("disabled cache sent %d commands", fake.commands)
+	}
+}
+
+func TestCacheUnavailable(t *testing.T) {
+	ctx := context.Background()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+	useClient(t, addr)
+	cache := NewCache("applications", time.Minute)
+	cache.Set(ctx, "a", &value{Name: "a"})
+	if cache.Get(ctx, "a", &value{}) {
+		t.Error("unavailable cache returned a value")
+	}
+	cache.Invalidate(ctx, "a")
+}
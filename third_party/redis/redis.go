@@ -2,29 +2,32 @@
 package redis
 
 import (
-	"os"
+	"sync"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
 )
 
-var client *redis.Client
+var (
+	client     *redis.Client
+	clientOnce sync.Once
+)
 
 // Client retur nredis client
 func Client() *redis.Client {
-	if client == nil {
-		NewClient()
-	}
+	// Repos ask for the client concurrently
+	clientOnce.Do(func() {
+		if client == nil {
+			NewClient()
+		}
+	})
 	return client
 }
 
 // NewClient create redis client
 func NewClient() error {
-	redisAddr, exists := os.LookupEnv("REDIS_HOST")
-	if !exists {
-		redisAddr = "localhost:6379"
-	}
 	client = redis.NewClient(&redis.Options{
-		Addr:     redisAddr,
+		Addr:     viper.GetString("redis_host"),
 		Password: "", // no password set
 		DB:       0,  // use default DB
 	})
@@ -0,0 +1,194 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/badhouseplants/envspotting-apps/third_party/github"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// githubProvider implements Provider over the github deployments and environments api
+type githubProvider struct {
+	client *github.Client
+	// names caches full names of repositories by their ids
+	mu    sync.Mutex
+	names map[int64]string
+}
+
+// NewGithub provider
+func NewGithub(client *github.Client) Provider {
+	return &githubProvider{
+		client: client,
+		names:  map[int64]string{},
+	}
+}
+
+func (p *githubProvider) Host() string {
+	return p.client.BaseURL().Host
+}
+
+func (p *githubProvider) GetProject(ctx context.Context, project int64) (*Project, error) {
+	repo, err := p.client.GetRepository(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.names[project] = repo.FullName
+	p.mu.Unlock()
+	return &Project{
+		ID:            repo.ID,
+		Path:          repo.FullName,
+		DefaultBranch: repo.DefaultBranch,
+		WebURL:        repo.HTMLURL,
+	}, nil
+}
+
+func (p *githubProvider) GetEnvironment(ctx context.Context, project, environment int64) (*Environment, error) {
+	fullName, err := p.fullName(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	envs, err := p.client.ListEnvironments(ctx, fullName)
+	if err != nil {
+		return nil, err
+	}
+	for _, env := range envs {
+		if env.ID != environment {
+			continue
+		}
+		out := &Environment{ID: env.ID, Name: env.Name}
+		deployments, _, err := p.client.ListDeployments(ctx, fullName, env.Name, 1, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(deployments) > 0 {
+			deployment, deploymentStatus, err := p.deployment(ctx, fullName, deployments[0])
+			if err != nil {
+				return nil, err
+			}
+			out.LastDeployment = deployment
+			if deploymentStatus != nil {
+				out.ExternalURL = deploymentStatus.EnvironmentURL
+			}
+		}
+		return out, nil
+	}
+	return nil, status.Error(codes.NotFound, fmt.Sprintf("environment %d can't be found in the repository %s", environment, fullName))
+}
+
+// CreateEnvironment of github creates or updates the environment, so an existing one is kept as it is
+func (p *githubProvider) CreateEnvironment(ctx context.Context, project int64, name string) (*Environment, error) {
+	fullName, err := p.fullName(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	env, err := p.client.CreateEnvironment(ctx, fullName, name)
+	if err != nil {
+		return nil, err
+	}
+	return &Environment{ID: env.ID, Name: env.Name}, nil
+}
+
+func (p *githubProvider) ResolveRef(ctx context.Context, project int64, ref string) (string, error) {
+	fullName, err := p.fullName(ctx, project)
+	if err != nil {
+		return "", err
+	}
+	commit, err := p.client.GetCommit(ctx, fullName, ref)
+	if err != nil {
+		return "", err
+	}
+	return commit.SHA, nil
+}
+
+// ListDeployments of github are filtered by status and time here, the api can't do it
+func (p *githubProvider) ListDeployments(ctx context.Context, project int64, query *DeploymentsQuery) ([]*Deployment, int, error) {
+	fullName, err := p.fullName(ctx, project)
+	if err != nil {
+		return nil, 0, err
+	}
+	deployments, next, err := p.client.ListDeployments(ctx, fullName, query.Environment, query.Page, query.PerPage)
+	if err != nil {
+		return nil, 0, err
+	}
+	var out []*Deployment
+	for _, d := range deployments {
+		if query.Until != nil && d.CreatedAt != nil && d.CreatedAt.After(*query.Until) {
+			continue
+		}
+		if query.Since != nil && d.CreatedAt != nil && d.CreatedAt.Before(*query.Since) {
+			// Deployments are sorted newest first, so the rest is older too
+			return out, 0, nil
+		}
+		deployment, _, err := p.deployment(ctx, fullName, d)
+		if err != nil {
+			return nil, 0, err
+		}
+		if query.Status != "" && deployment.Status != query.Status {
+			continue
+		}
+		out = append(out, deployment)
+	}
+	return out, next, nil
+}
+
+// deployment with the status of its latest state
+func (p *githubProvider) deployment(ctx context.Context, fullName string, d *github.Deployment) (*Deployment, *github.DeploymentStatus, error) {
+	deploymentStatus, err := p.client.LatestDeploymentStatus(ctx, fullName, d.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	out := &Deployment{
+		ID:        d.ID,
+		Ref:       d.Ref,
+		SHA:       d.SHA,
+		Status:    "created",
+		UpdatedAt: d.UpdatedAt,
+	}
+	if d.Creator != nil {
+		out.Deployer = d.Creator.Login
+	}
+	if deploymentStatus != nil {
+		out.Status = githubStatus(deploymentStatus.State)
+		if out.Status == "success" || out.Status == "failed" {
+			out.FinishedAt = deploymentStatus.CreatedAt
+		}
+	}
+	return out, deploymentStatus, nil
+}
+
+func (p *githubProvider) fullName(ctx context.Context, project int64) (string, error) {
+	p.mu.Lock()
+	name, ok := p.names[project]
+	p.mu.Unlock()
+	if ok {
+		return name, nil
+	}
+	repo, err := p.GetProject(ctx, project)
+	if err != nil {
+		return "", err
+	}
+	return repo.Path, nil
+}
+
+// githubStatus maps github deployment states onto gitlab deployment statuses.
+// Inactive deployments were replaced by newer ones, nothing tells if they succeeded
+func githubStatus(state string) string {
+	switch state {
+	case "success":
+		return "success"
+	case "inactive":
+		return StatusSuperseded
+	case "failure", "error":
+		return "failed"
+	case "in_progress":
+		return "running"
+	case "queued", "pending":
+		return "created"
+	default:
+		return state
+	}
+}
@@ -0,0 +1,232 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/badhouseplants/envspotting-apps/third_party/github"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// githubDeployments of the staging environment of org/repo newest first, two per page
+var githubDeployments = []struct {
+	id    int64
+	state string
+	hour  int
+}{
+	{id: 4, state: "in_progress", hour: 4},
+	{id: 3, state: "success", hour: 3},
+	{id: 2, state: "failure", hour: 2},
+	{id: 1, state: "inactive", hour: 1},
+}
+
+// newGithubFake serves the repository 1 named org/repo with the environment 10 named staging
+func newGithubFake(t *testing.T) Provider {
+	mux := http.NewServeMux()
+	reply := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+	at := func(hour int) string {
+		return time.Date(2021, 8, 1, hour, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	}
+	mux.HandleFunc("/repositories/1", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"id": 1, "full_name": "org/repo", "default_branch": "main", "html_url": "https://github.com/org/repo"})
+	})
+	mux.HandleFunc("/repos/org/repo/environments", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"environments": []map[string]interface{}{{"id": 10, "name": "staging"}}})
+	})
+	mux.HandleFunc("/repos/org/repo/environments/review", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		reply(w, map[string]interface{}{"id": 20, "name": "review"})
+	})
+	mux.HandleFunc("/repos/org/repo/commits/main", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"sha": "279484c09fbe69ededfced8c1bb6e6d24616b468"})
+	})
+	mux.HandleFunc("/repos/org/repo/deployments", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("environment") != "staging" {
+			reply(w, []interface{}{})
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if page < 1 || size < 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var deployments []map[string]interface{}
+		for i := (page - 1) * size; i < page*size && i < len(githubDeployments); i++ {
+			d := githubDeployments[i]
+			deployments = append(deployments, map[string]interface{}{
+				"id": d.id, "sha": fmt.Sprintf("sha%d", d.id), "ref": "main", "environment": "staging",
+				"creator":    map[string]string{"login": "deployer"},
+				"created_at": at(d.hour), "updated_at": at(d.hour),
+			})
+		}
+		if page*size < len(githubDeployments) {
+			next := *r.URL
+			query := next.Query()
+			query.Set("page", strconv.Itoa(page+1))
+			next.RawQuery = query.Encode()
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel="next"`, r.Host, next.String()))
+		}
+		reply(w, deployments)
+	})
+	for _, d := range githubDeployments {
+		d := d
+		mux.HandleFunc(fmt.Sprintf("/repos/org/repo/deployments/%d/statuses", d.id), func(w http.ResponseWriter, r *http.Request) {
+			reply(w, []map[string]interface{}{{
+				"state": d.state, "environment_url": "https://staging.example.com", "created_at": at(d.hour),
+			}})
+		})
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client, err := github.NewClient(&github.Connection{URL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewGithub(client)
+}
+
+func TestGithubStatus(t *testing.T) {
+	tests := []struct {
+		state string
+		want  string
+	}{
+		{state: "success", want: "success"},
+		{state: "inactive", want: StatusSuperseded},
+		{state: "failure", want: "failed"},
+		{state: "error", want: "failed"},
+		{state: "in_progress", want: "running"},
+		{state: "queued", want: "created"},
+		{state: "pending", want: "created"},
+		{state: "unexpected", want: "unexpected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			if got := githubStatus(tt.state); got != tt.want {
+				t.Errorf("githubStatus(%q) = %q, want %q", tt.state, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGithubProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := newGithubFake(t)
+
+	project, err := provider.GetProject(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.Path != "org/repo" || project.DefaultBranch != "main" {
+		t.Errorf("project = %+v", project)
+	}
+
+	env, err := provider.GetEnvironment(ctx, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Name != "staging" || env.ExternalURL != "https://staging.example.com" {
+		t.Errorf("environment = %+v", env)
+	}
+	if d := env.LastDeployment; d == nil || d.ID != 4 || d.Status != "running" || d.Deployer != "deployer" || d.FinishedAt != nil {
+		t.Errorf("last deployment = %+v", d)
+	}
+
+	if _, err := provider.GetEnvironment(ctx, 1, 11); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown environment error = %v, want NotFound", err)
+	}
+
+	created, err := provider.CreateEnvironment(ctx, 1, "review")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID != 20 || created.Name != "review" {
+		t.Errorf("created environment = %+v", created)
+	}
+
+	sha, err := provider.ResolveRef(ctx, 1, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sha != "279484c09fbe69ededfced8c1bb6e6d24616b468" {
+		t.Errorf("sha = %q", sha)
+	}
+
+	if _, err := provider.GetProject(ctx, 2); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown project error = %v, want NotFound", err)
+	}
+}
+
+func TestGithubListDeployments(t *testing.T) {
+	since := time.Date(2021, 8, 1, 2, 0, 0, 0, time.UTC)
+	until := time.Date(2021, 8, 1, 3, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		query    DeploymentsQuery
+		want     []int64
+		statuses []string
+	}{
+		{
+			name:     "all pages",
+			query:    DeploymentsQuery{Environment: "staging"},
+			want:     []int64{4, 3, 2, 1},
+			statuses: []string{"running", "success", "failed", StatusSuperseded},
+		},
+		{
+			name:     "filtered by status",
+			query:    DeploymentsQuery{Environment: "staging", Status: "success"},
+			want:     []int64{3},
+			statuses: []string{"success"},
+		},
+		{
+			name:     "filtered by time",
+			query:    DeploymentsQuery{Environment: "staging", Since: &since, Until: &until},
+			want:     []int64{3, 2},
+			statuses: []string{"success", "failed"},
+		},
+		{
+			name:  "unknown environment",
+			query: DeploymentsQuery{Environment: "production"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newGithubFake(t)
+			query := tt.query
+			query.Page, query.PerPage = 1, 2
+			var got []*Deployment
+			for {
+				deployments, next, err := provider.ListDeployments(context.Background(), 1, &query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, deployments...)
+				if next == 0 {
+					break
+				}
+				query.Page = next
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d deployments, want %v", len(got), tt.want)
+			}
+			for i, d := range got {
+				if d.ID != tt.want[i] || d.Status != tt.statuses[i] {
+					t.Errorf("deployments[%d] = %d %s, want %d %s", i, d.ID, d.Status, tt.want[i], tt.statuses[i])
+				}
+			}
+		})
+	}
+}
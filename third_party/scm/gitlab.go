@@ -0,0 +1,118 @@
+package scm
+
+import (
+	"context"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"github.com/xanzy/go-gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gitlabProvider implements Provider over the gitlab api
+type gitlabProvider struct {
+	git *gitlabClient.Client
+}
+
+// NewGitlab provider
+func NewGitlab(git *gitlabClient.Client) Provider {
+	return &gitlabProvider{git: git}
+}
+
+func (p *gitlabProvider) Host() string {
+	return p.git.BaseURL().Host
+}
+
+func (p *gitlabProvider) GetProject(ctx context.Context, project int64) (*Project, error) {
+	proj, err := p.git.WithContext(ctx).GetProject(project)
+	if err != nil {
+		return nil, err
+	}
+	return &Project{
+		ID:            int64(proj.ID),
+		Path:          proj.PathWithNamespace,
+		DefaultBranch: proj.DefaultBranch,
+		WebURL:        proj.WebURL,
+	}, nil
+}
+
+func (p *gitlabProvider) GetEnvironment(ctx context.Context, project, environment int64) (*Environment, error) {
+	env, err := p.git.WithContext(ctx).GetEnvironment(project, environment)
+	if err != nil {
+		return nil, err
+	}
+	out := &Environment{
+		ID:          int64(env.ID),
+		Name:        env.Name,
+		ExternalURL: env.ExternalURL,
+	}
+	if env.LastDeployment != nil {
+		out.LastDeployment = fromGitlabDeployment(env.LastDeployment)
+	}
+	return out, nil
+}
+
+func (p *gitlabProvider) CreateEnvironment(ctx context.Context, project int64, name string) (*Environment, error) {
+	env, err := p.git.WithContext(ctx).FindEnvironment(project, name)
+	if status.Code(err) == codes.NotFound {
+		env, err = p.git.WithContext(ctx).CreateEnvironment(project, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Environment{
+		ID:          int64(env.ID),
+		Name:        env.Name,
+		ExternalURL: env.ExternalURL,
+	}, nil
+}
+
+func (p *gitlabProvider) ResolveRef(ctx context.Context, project int64, ref string) (string, error) {
+	commit, err := p.git.WithContext(ctx).GetCommit(project, ref)
+	if err != nil {
+		return "", err
+	}
+	return commit.ID, nil
+}
+
+func (p *gitlabProvider) ListDeployments(ctx context.Context, project int64, query *DeploymentsQuery) ([]*Deployment, int, error) {
+	opts := &gitlab.ListProjectDeploymentsOptions{
+		ListOptions:   gitlab.ListOptions{Page: query.Page, PerPage: query.PerPage},
+		Environment:   gitlab.String(query.Environment),
+		OrderBy:       gitlab.String("updated_at"),
+		Sort:          gitlab.String("desc"),
+		UpdatedAfter:  query.Since,
+		UpdatedBefore: query.Until,
+	}
+	if query.Status != "" {
+		opts.Status = gitlab.String(query.Status)
+	}
+	deployments, next, err := p.git.WithContext(ctx).ListDeployments(project, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([]*Deployment, 0, len(deployments))
+	for _, deployment := range deployments {
+		out = append(out, fromGitlabDeployment(deployment))
+	}
+	return out, next, nil
+}
+
+func fromGitlabDeployment(deployment *gitlab.Deployment) *Deployment {
+	out := &Deployment{
+		ID:         int64(deployment.ID),
+		Ref:        deployment.Ref,
+		SHA:        deployment.SHA,
+		Status:     deployment.Status,
+		UpdatedAt:  deployment.UpdatedAt,
+		FinishedAt: deployment.Deployable.FinishedAt,
+	}
+	if deployment.User != nil {
+		out.Deployer = deployment.User.Username
+	}
+	if commit := deployment.Deployable.Commit; commit != nil {
+		out.CommitTitle = commit.Title
+		out.Author = commit.AuthorName
+	}
+	return out
+}
@@ -0,0 +1,199 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	gitlabClient "github.com/badhouseplants/envspotting-apps/third_party/gitlab"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gitlabDeployments of the staging environment of the project 1 newest first
+var gitlabDeployments = []map[string]interface{}{
+	gitlabDeployment(3, "running", 3),
+	gitlabDeployment(2, "success", 2),
+	gitlabDeployment(1, "failed", 1),
+}
+
+func gitlabDeployment(id int, status string, hour int) map[string]interface{} {
+	at := time.Date(2021, 8, 1, hour, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	return map[string]interface{}{
+		"id": id, "ref": "main", "sha": "sha" + strconv.Itoa(id), "status": status, "updated_at": at,
+		"user": map[string]string{"username": "deployer"},
+		"deployable": map[string]interface{}{
+			"finished_at": at,
+			"commit":      map[string]string{"title": "Fix the build", "author_name": "Author"},
+		},
+	}
+}
+
+// newGitlabFake serves the project 1 named group/project with the environment 10 named staging
+func newGitlabFake(t *testing.T) Provider {
+	mux := http.NewServeMux()
+	reply := func(w http.ResponseWriter, code int, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(v)
+	}
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK, map[string]interface{}{"id": 1, "path_with_namespace": "group/project", "default_branch": "main"})
+	})
+	mux.HandleFunc("/api/v4/projects/1/environments/10", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK, map[string]interface{}{
+			"id": 10, "name": "staging", "external_url": "https://staging.example.com",
+			"last_deployment": gitlabDeployments[0],
+		})
+	})
+	mux.HandleFunc("/api/v4/projects/1/environments", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			reply(w, http.StatusCreated, map[string]interface{}{"id": 20, "name": "review"})
+		case r.URL.Query().Get("name") == "staging":
+			reply(w, http.StatusOK, []map[string]interface{}{{"id": 10, "name": "staging"}})
+		default:
+			reply(w, http.StatusOK, []interface{}{})
+		}
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/main", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK, map[string]interface{}{"id": "279484c09fbe69ededfced8c1bb6e6d24616b468"})
+	})
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if page < 1 || size < 1 {
+			reply(w, http.StatusBadRequest, map[string]string{"message": "page is required"})
+			return
+		}
+		var deployments []map[string]interface{}
+		for i := (page - 1) * size; i < page*size && i < len(gitlabDeployments); i++ {
+			if want := r.URL.Query().Get("status"); want != "" && gitlabDeployments[i]["status"] != want {
+				continue
+			}
+			deployments = append(deployments, gitlabDeployments[i])
+		}
+		if page*size < len(gitlabDeployments) {
+			w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+		}
+		reply(w, http.StatusOK, deployments)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusNotFound, map[string]string{"message": "404 Not Found"})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	git, err := gitlabClient.NewClient(&gitlabClient.Connection{URL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewGitlab(git)
+}
+
+func TestGitlabProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := newGitlabFake(t)
+
+	project, err := provider.GetProject(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.Path != "group/project" || project.DefaultBranch != "main" {
+		t.Errorf("project = %+v", project)
+	}
+
+	env, err := provider.GetEnvironment(ctx, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Name != "staging" || env.ExternalURL != "https://staging.example.com" {
+		t.Errorf("environment = %+v", env)
+	}
+	d := env.LastDeployment
+	if d == nil || d.ID != 3 || d.Status != "running" || d.Deployer != "deployer" || d.CommitTitle != "Fix the build" || d.Author != "Author" {
+		t.Errorf("last deployment = %+v", d)
+	}
+
+	if _, err := provider.GetEnvironment(ctx, 1, 11); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown environment error = %v, want NotFound", err)
+	}
+
+	existing, err := provider.CreateEnvironment(ctx, 1, "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if existing.ID != 10 {
+		t.Errorf("existing environment = %+v, want the one with id 10", existing)
+	}
+	created, err := provider.CreateEnvironment(ctx, 1, "review")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID != 20 || created.Name != "review" {
+		t.Errorf("created environment = %+v", created)
+	}
+
+	sha, err := provider.ResolveRef(ctx, 1, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sha != "279484c09fbe69ededfced8c1bb6e6d24616b468" {
+		t.Errorf("sha = %q", sha)
+	}
+
+	if _, err := provider.GetProject(ctx, 2); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown project error = %v, want NotFound", err)
+	}
+}
+
+func TestGitlabListDeployments(t *testing.T) {
+	tests := []struct {
+		name  string
+		query DeploymentsQuery
+		want  []int64
+	}{
+		{
+			name:  "all pages",
+			query: DeploymentsQuery{Environment: "staging"},
+			want:  []int64{3, 2, 1},
+		},
+		{
+			name:  "filtered by status",
+			query: DeploymentsQuery{Environment: "staging", Status: "success"},
+			want:  []int64{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newGitlabFake(t)
+			query := tt.query
+			query.Page, query.PerPage = 1, 2
+			var got []int64
+			for {
+				deployments, next, err := provider.ListDeployments(context.Background(), 1, &query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, d := range deployments {
+					got = append(got, d.ID)
+				}
+				if next == 0 {
+					break
+				}
+				query.Page = next
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("deployments = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("deployments = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Provider types
+const (
+	ProviderGitlab = "gitlab"
+	ProviderGithub = "github"
+)
+
+// Project is a repository hosted by a provider
+type Project struct {
+	ID            int64
+	Path          string
+	DefaultBranch string
+	WebURL        string
+}
+
+// Environment of a project
+type Environment struct {
+	ID          int64
+	Name        string
+	ExternalURL string
+	// LastDeployment is nil if the environment has never been deployed
+	LastDeployment *Deployment
+}
+
+// StatusSuperseded is the status of github deployments replaced by newer ones, gitlab has no such status
+const StatusSuperseded = "superseded"
+
+// Deployment to an environment. Statuses are normalized to gitlab ones:
+// created, running, success, failed, canceled, and StatusSuperseded
+type Deployment struct {
+	ID          int64
+	Ref         string
+	SHA         string
+	Status      string
+	Deployer    string
+	CommitTitle string
+	Author      string
+	UpdatedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+// DeploymentsQuery selects one page of environment deployments, newest first
+type DeploymentsQuery struct {
+	Environment string
+	// Status is optional
+	Status  string
+	Since   *time.Time
+	Until   *time.Time
+	Page    int
+	PerPage int
+}
+
+// Provider of environments and deployments
+type Provider interface {
+	// Host of the provider api, used to rate limit requests
+	Host() string
+	GetProject(ctx context.Context, project int64) (*Project, error)
+	GetEnvironment(ctx context.Context, project, environment int64) (*Environment, error)
+	// CreateEnvironment returns the environment with the name, it's created if the project has none
+	CreateEnvironment(ctx context.Context, project int64, name string) (*Environment, error)
+	// ResolveRef returns the full sha of a tag, a branch or a sha
+	ResolveRef(ctx context.Context, project int64, ref string) (string, error)
+	// ListDeployments returns a page of deployments and the next page number, 0 on the last page
+	ListDeployments(ctx context.Context, project int64, query *DeploymentsQuery) ([]*Deployment, int, error)
+}
+
+// ValidateType of a provider, empty type means gitlab
+func ValidateType(providerType string) (string, error) {
+	switch providerType {
+	case "", ProviderGitlab:
+		return ProviderGitlab, nil
+	case ProviderGithub:
+		return ProviderGithub, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("unknown provider: %s", providerType))
+	}
+}
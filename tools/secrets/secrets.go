@@ -0,0 +1,66 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+
+	"github.com/spf13/viper"
+)
+
+const keySize = 32
+
+var (
+	errKeyNotSet          = errors.New("secrets encryption key is not configured")
+	errKeyWrongSize       = errors.New("secrets encryption key must be 32 bytes long")
+	errCiphertextTooShort = errors.New("ciphertext is too short")
+)
+
+// Encrypt data with aes-gcm, the nonce is prepended to the ciphertext
+func Encrypt(plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt data encrypted by Encrypt
+func Decrypt(ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM()
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errCiphertextTooShort
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// newGCM with the base64 encoded key from the secrets_encryption_key variable
+func newGCM() (cipher.AEAD, error) {
+	encoded := viper.GetString("secrets_encryption_key")
+	if encoded == "" {
+		return nil, errKeyNotSet
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != keySize {
+		return nil, errKeyWrongSize
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
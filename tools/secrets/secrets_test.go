@@ -0,0 +1,84 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func withKey(t *testing.T, key string) {
+	previous := viper.GetString("secrets_encryption_key")
+	viper.Set("secrets_encryption_key", key)
+	t.Cleanup(func() { viper.Set("secrets_encryption_key", previous) })
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	withKey(t, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, keySize)))
+	for _, plaintext := range [][]byte{[]byte("secret value"), {}} {
+		first, err := Encrypt(plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := Encrypt(plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(first, second) {
+			t.Errorf("ciphertexts of %q are equal, want a random nonce", plaintext)
+		}
+		got, err := Decrypt(first)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Errorf("Decrypt = %q, want %q", got, plaintext)
+		}
+	}
+}
+
+func TestDecryptTampered(t *testing.T) {
+	withKey(t, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, keySize)))
+	ciphertext, err := Encrypt([]byte("secret value"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext[len(ciphertext)-1] ^= 1
+	if _, err := Decrypt(ciphertext); err == nil {
+		t.Error("Decrypt of a tampered ciphertext succeeded")
+	}
+	if _, err := Decrypt(ciphertext[:4]); err != errCiphertextTooShort {
+		t.Errorf("Decrypt of a short ciphertext = %v, want %v", err, errCiphertextTooShort)
+	}
+
+	withKey(t, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, keySize)))
+	ciphertext[len(ciphertext)-1] ^= 1
+	if _, err := Decrypt(ciphertext); err == nil {
+		t.Error("Decrypt with another key succeeded")
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		err  error
+	}{
+		{name: "not set", key: "", err: errKeyNotSet},
+		{name: "wrong size", key: base64.StdEncoding.EncodeToString(make([]byte, 16)), err: errKeyWrongSize},
+		{name: "not base64", key: "not base64!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withKey(t, tt.key)
+			_, err := Encrypt([]byte("secret value"))
+			if err == nil {
+				t.Fatal("Encrypt succeeded")
+			}
+			if tt.err != nil && err != tt.err {
+				t.Errorf("Encrypt = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
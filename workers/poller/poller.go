@@ -0,0 +1,254 @@
+package poller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	appsService "github.com/badhouseplants/envspotting-apps/service/applications"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+var initRepo = func(ctx context.Context) repo.DeploymentStateStore {
+	return repo.DeploymentStateRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+var (
+	poller     *Poller
+	pollerOnce sync.Once
+)
+
+// Poller refreshes deployment states of contour services from their providers
+type Poller struct {
+	interval    time.Duration
+	concurrency int
+	rateLimit   rate.Limit
+	backoffBase time.Duration
+	backoffMax  time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	failures map[string]*failure
+}
+
+// failure of a service refresh, the service is skipped until retryAt
+type failure struct {
+	count   int
+	retryAt time.Time
+}
+
+// Get the poller configured by poller_* variables
+func Get() *Poller {
+	pollerOnce.Do(func() {
+		poller = &Poller{
+			interval:    viper.GetDuration("poller_interval"),
+			concurrency: viper.GetInt("poller_concurrency"),
+			rateLimit:   rate.Limit(viper.GetFloat64("poller_rate_limit")),
+			backoffBase: viper.GetDuration("poller_backoff_base"),
+			backoffMax:  viper.GetDuration("poller_backoff_max"),
+			limiters:    map[string]*rate.Limiter{},
+			failures:    map[string]*failure{},
+		}
+		if poller.concurrency < 1 {
+			poller.concurrency = 1
+		}
+		if poller.rateLimit <= 0 {
+			poller.rateLimit = rate.Inf
+		}
+	})
+	return poller
+}
+
+// Start polling in background if it's enabled
+func Start(ctx context.Context) error {
+	if !viper.GetBool("poller_enabled") {
+		logger.GetServerLogger().Info("deployment poller is disabled")
+		return nil
+	}
+	p := Get()
+	if p.interval <= 0 {
+		return fmt.Errorf("poller_interval must be positive, got %s", p.interval)
+	}
+	go p.Run(ctx)
+	return nil
+}
+
+// Run polls all contours every interval until the context is done
+func (p *Poller) Run(ctx context.Context) {
+	log := logger.GetServerLogger()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		services, err := initRepo(ctx).ListAllServices(ctx)
+		if err != nil {
+			log.Error(err)
+		} else {
+			p.Refresh(ctx, services, false)
+			p.prune(services)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh deployment states of services and return the ones that failed.
+// Services in backoff are skipped unless force is set
+func (p *Poller) Refresh(ctx context.Context, services []*repo.ContourService, force bool) map[string]error {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errs    = map[string]error{}
+		clients = newClients()
+		sem     = make(chan struct{}, p.concurrency)
+	)
+	for _, service := range services {
+		key := serviceKey(service)
+		if !force && p.backingOff(key) {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(service *repo.ContourService) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			err := p.refreshService(ctx, clients, service)
+			p.recordResult(key, err)
+			if err != nil {
+				mu.Lock()
+				errs[service.Service.GetId()] = err
+				mu.Unlock()
+			}
+		}(service)
+	}
+	wg.Wait()
+	return errs
+}
+
+func (p *Poller) refreshService(ctx context.Context, clients *clients, service *repo.ContourService) error {
+	provider, err := clients.get(ctx, service.AppID, service.Provider)
+	if err != nil {
+		return err
+	}
+	if err := p.limiter(provider.Host()).Wait(ctx); err != nil {
+		return err
+	}
+	env, err := provider.GetEnvironment(ctx, service.Service.GetProject(), service.Service.GetEnvironment())
+	if err != nil {
+		return err
+	}
+	state := &repo.DeploymentState{
+		ContourID:   service.ContourID,
+		ServiceID:   service.Service.GetId(),
+		Project:     service.Service.GetProject(),
+		Environment: service.Service.GetEnvironment(),
+		ExternalURL: env.ExternalURL,
+		UpdatedAt:   time.Now(),
+	}
+	if deployment := env.LastDeployment; deployment != nil {
+		state.DeploymentID = deployment.ID
+		state.Ref = deployment.Ref
+		state.SHA = deployment.SHA
+		state.Status = deployment.Status
+		state.FinishedAt = deployment.FinishedAt
+		state.Deployer = deployment.Deployer
+		state.CommitTitle = deployment.CommitTitle
+	}
+	return initRepo(ctx).Upsert(ctx, state)
+}
+
+// limiter of requests to one provider host
+func (p *Poller) limiter(host string) *rate.Limiter {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	limiter, ok := p.limiters[host]
+	if !ok {
+		limiter = rate.NewLimiter(p.rateLimit, 1)
+		p.limiters[host] = limiter
+	}
+	return limiter
+}
+
+func (p *Poller) backingOff(key string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f, ok := p.failures[key]
+	return ok && time.Now().Before(f.retryAt)
+}
+
+// recordResult of a refresh, the backoff doubles with every failure in a row
+func (p *Poller) recordResult(key string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err == nil {
+		delete(p.failures, key)
+		return
+	}
+	f, ok := p.failures[key]
+	if !ok {
+		f = &failure{}
+		p.failures[key] = f
+	}
+	f.count++
+	backoff := p.backoffMax
+	if shift := f.count - 1; shift < 32 && p.backoffBase<<shift < p.backoffMax {
+		backoff = p.backoffBase << shift
+	}
+	f.retryAt = time.Now().Add(backoff)
+}
+
+// prune failures of services that are gone from contours
+func (p *Poller) prune(services []*repo.ContourService) {
+	known := make(map[string]bool, len(services))
+	for _, service := range services {
+		known[serviceKey(service)] = true
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key := range p.failures {
+		if !known[key] {
+			delete(p.failures, key)
+		}
+	}
+}
+
+func serviceKey(service *repo.ContourService) string {
+	return service.ContourID + "/" + service.Service.GetId()
+}
+
+// clients caches providers of applications during one refresh
+type clients struct {
+	mu      sync.Mutex
+	clients map[string]scm.Provider
+}
+
+func newClients() *clients {
+	return &clients{clients: map[string]scm.Provider{}}
+}
+
+func (c *clients) get(ctx context.Context, appID, providerType string) (scm.Provider, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := appID + "/" + providerType
+	if provider, ok := c.clients[key]; ok {
+		return provider, nil
+	}
+	provider, err := appsService.Provider(ctx, &applications.AppId{Id: appID}, providerType)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[key] = provider
+	return provider, nil
+}
@@ -0,0 +1,65 @@
+package poller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/deployments"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/contours"
+)
+
+func TestRecordResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		results  []error
+		backoff  time.Duration
+		failures int
+	}{
+		{name: "success", results: []error{nil}},
+		{name: "one failure", results: []error{errors.New("boom")}, backoff: time.Second, failures: 1},
+		{name: "backoff doubles", results: []error{errors.New("boom"), errors.New("boom"), errors.New("boom")}, backoff: 4 * time.Second, failures: 3},
+		{name: "backoff is capped", results: []error{errors.New("boom"), errors.New("boom"), errors.New("boom"), errors.New("boom"), errors.New("boom")}, backoff: 10 * time.Second, failures: 5},
+		{name: "success resets", results: []error{errors.New("boom"), errors.New("boom"), nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Poller{backoffBase: time.Second, backoffMax: 10 * time.Second, failures: map[string]*failure{}}
+			before := time.Now()
+			for _, err := range tt.results {
+				p.recordResult("contour/service", err)
+			}
+			f, ok := p.failures["contour/service"]
+			if tt.failures == 0 {
+				if ok {
+					t.Fatalf("failure = %+v, want none", f)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("failure isn't recorded")
+			}
+			if f.count != tt.failures {
+				t.Errorf("count = %d, want %d", f.count, tt.failures)
+			}
+			if backoff := f.retryAt.Sub(before); backoff < tt.backoff || backoff > tt.backoff+time.Second {
+				t.Errorf("backoff = %s, want %s", backoff, tt.backoff)
+			}
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	service := func(contourID, serviceID string) *repo.ContourService {
+		return &repo.ContourService{ContourID: contourID, Service: &contours.ServiceInfo{Id: serviceID}}
+	}
+	p := &Poller{failures: map[string]*failure{
+		"contour/kept":    {count: 1},
+		"contour/removed": {count: 2},
+		"gone/service":    {count: 3},
+	}}
+	p.prune([]*repo.ContourService{service("contour", "kept"), service("contour", "new")})
+	if len(p.failures) != 1 || p.failures["contour/kept"] == nil {
+		t.Errorf("failures = %v, want only contour/kept", p.failures)
+	}
+}
@@ -0,0 +1,116 @@
+package renames
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/projects"
+	appsService "github.com/badhouseplants/envspotting-apps/service/applications"
+	"github.com/badhouseplants/envspotting-apps/third_party/postgres"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-apps/tools/logger"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var initRepo = func(ctx context.Context) repo.ServiceProjectStore {
+	return repo.ServiceProjectRepo{
+		Pool:      postgres.Pool(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+var initProvider = appsService.Provider
+
+// Start checking project paths in background if it's enabled
+func Start(ctx context.Context) error {
+	if !viper.GetBool("renames_enabled") {
+		logger.GetServerLogger().Info("project renames job is disabled")
+		return nil
+	}
+	interval := viper.GetDuration("renames_interval")
+	if interval <= 0 {
+		return fmt.Errorf("renames_interval must be positive, got %s", interval)
+	}
+	go Run(ctx, interval)
+	return nil
+}
+
+// Run checks project paths every interval until the context is done
+func Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := Check(ctx); err != nil {
+			logger.GetServerLogger().Error(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check looks every service project up by its id and stores
+// the new path if the project was renamed or transferred.
+// Projects without a known path were backfilled from existing
+// services, their current path is stored without an audit entry.
+// Errors of single projects are logged and the others are checked anyway
+func Check(ctx context.Context) error {
+	log := logger.GetServerLogger()
+	projects, err := initRepo(ctx).ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	providers := map[string]scm.Provider{}
+	found := map[string]*scm.Project{}
+	var backfill []*repo.ServiceProject
+	for _, project := range projects {
+		providerKey := project.AppID + "/" + project.Provider
+		projectKey := fmt.Sprintf("%s/%d", providerKey, project.Project)
+		current, ok := found[projectKey]
+		if !ok {
+			provider, ok := providers[providerKey]
+			if !ok {
+				provider, err = initProvider(ctx, &applications.AppId{Id: project.AppID}, project.Provider)
+				if err != nil {
+					log.Error(err)
+					continue
+				}
+				providers[providerKey] = provider
+			}
+			current, err = provider.GetProject(ctx, project.Project)
+			if status.Code(err) == codes.NotFound {
+				log.Warnf("project %d of the service %s is gone", project.Project, project.ServiceID)
+			} else if err != nil {
+				log.Error(err)
+			}
+			// projects that failed are not looked up again during this check
+			found[projectKey] = current
+		}
+		if current == nil || current.Path == project.Path {
+			continue
+		}
+		if project.Path == "" {
+			backfilled := *project
+			backfilled.Path = current.Path
+			backfill = append(backfill, &backfilled)
+			continue
+		}
+		log.Infof("project %d was moved from %s to %s", project.Project, project.Path, current.Path)
+		if err := initRepo(ctx).Rename(ctx, project, current.Path); err != nil {
+			log.Error(err)
+		}
+	}
+	if len(backfill) > 0 {
+		log.Infof("storing paths of %d backfilled service projects", len(backfill))
+		if err := initRepo(ctx).Set(ctx, backfill); err != nil {
+			log.Error(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package renames
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	repo "github.com/badhouseplants/envspotting-apps/repo/projects"
+	"github.com/badhouseplants/envspotting-apps/third_party/scm"
+	"github.com/badhouseplants/envspotting-go-proto/models/apps/applications"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeStore struct {
+	projects []*repo.ServiceProject
+	set      []*repo.ServiceProject
+	renamed  map[string]string
+	failing  map[string]bool
+}
+
+func (s *fakeStore) Set(_ context.Context, projects []*repo.ServiceProject) error {
+	s.set = append(s.set, projects...)
+	return nil
+}
+
+func (s *fakeStore) ListAll(context.Context) ([]*repo.ServiceProject, error) {
+	return s.projects, nil
+}
+
+func (s *fakeStore) Rename(_ context.Context, project *repo.ServiceProject, path string) error {
+	if s.failing[project.ServiceID] {
+		return errors.New("boom")
+	}
+	s.renamed[project.ServiceID] = path
+	return nil
+}
+
+// fakeProvider knows project paths by id and counts lookups
+type fakeProvider struct {
+	scm.Provider
+	paths   map[int64]string
+	lookups map[int64]int
+}
+
+func (p *fakeProvider) GetProject(_ context.Context, project int64) (*scm.Project, error) {
+	p.lookups[project]++
+	path, ok := p.paths[project]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	return &scm.Project{ID: project, Path: path}, nil
+}
+
+func TestCheck(t *testing.T) {
+	store := &fakeStore{
+		projects: []*repo.ServiceProject{
+			{AppID: "app", ServiceID: "moved", Project: 1, Path: "group/old"},
+			{AppID: "app", ServiceID: "moved-too", Project: 1, Path: "group/old"},
+			{AppID: "app", ServiceID: "failing", Project: 2, Path: "group/old"},
+			{AppID: "app", ServiceID: "same", Project: 3, Path: "group/same"},
+			{AppID: "app", ServiceID: "gone", Project: 4, Path: "group/gone"},
+			{AppID: "app", ServiceID: "backfilled", Project: 3},
+		},
+		renamed: map[string]string{},
+		failing: map[string]bool{"failing": true},
+	}
+	provider := &fakeProvider{
+		paths:   map[int64]string{1: "group/new", 2: "group/new", 3: "group/same"},
+		lookups: map[int64]int{},
+	}
+	defer func(initStore func(context.Context) repo.ServiceProjectStore, initApp func(context.Context, *applications.AppId, string) (scm.Provider, error)) {
+		initRepo, initProvider = initStore, initApp
+	}(initRepo, initProvider)
+	initRepo = func(context.Context) repo.ServiceProjectStore { return store }
+	initProvider = func(context.Context, *applications.AppId, string) (scm.Provider, error) { return provider, nil }
+
+	if err := Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"moved": "group/new", "moved-too": "group/new"}
+	if len(store.renamed) != len(want) {
+		t.Errorf("renamed = %v, want %v", store.renamed, want)
+	}
+	for service, path := range want {
+		if store.renamed[service] != path {
+			t.Errorf("renamed = %v, want %v", store.renamed, want)
+		}
+	}
+	if len(store.set) != 1 || store.set[0].ServiceID != "backfilled" || store.set[0].Path != "group/same" {
+		t.Errorf("set = %+v, want the backfilled service with group/same", store.set)
+	}
+	for project, lookups := range provider.lookups {
+		if lookups != 1 {
+			t.Errorf("project %d was looked up %d times, want once", project, lookups)
+		}
+	}
+}